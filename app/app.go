@@ -30,7 +30,10 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/supply"
 
 	govwrap "github.com/likecoin/likechain/x/gov"
+	"github.com/likecoin/likechain/x/poll"
 	stakingwrap "github.com/likecoin/likechain/x/staking"
+	"github.com/likecoin/likechain/x/upgrade"
+	upgradeclient "github.com/likecoin/likechain/x/upgrade/client"
 	"github.com/likecoin/likechain/x/whitelist"
 )
 
@@ -54,12 +57,17 @@ var (
 		staking.AppModuleBasic{},
 		mint.AppModuleBasic{},
 		distr.AppModuleBasic{},
-		gov.NewAppModuleBasic(paramsclient.ProposalHandler, distr.ProposalHandler),
+		govwrap.NewAppModuleBasic(
+			paramsclient.ProposalHandler, distr.ProposalHandler,
+			upgradeclient.ProposalHandler, upgradeclient.CancelProposalHandler,
+		),
 		params.AppModuleBasic{},
 		crisis.AppModuleBasic{},
 		slashing.AppModuleBasic{},
 		supply.AppModuleBasic{},
 		whitelist.AppModuleBasic{},
+		upgrade.AppModuleBasic{},
+		poll.AppModuleBasic{},
 	)
 
 	// module account permissions
@@ -108,6 +116,9 @@ type LikeApp struct {
 	crisisKeeper    crisis.Keeper
 	paramsKeeper    params.Keeper
 	whitelistKeeper whitelist.Keeper
+	govwrapKeeper   govwrap.Keeper
+	upgradeKeeper   upgrade.Keeper
+	pollKeeper      poll.Keeper
 
 	// the module manager
 	mm *module.Manager
@@ -126,7 +137,8 @@ func NewLikeApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest b
 	keys := sdk.NewKVStoreKeys(
 		bam.MainStoreKey, auth.StoreKey, staking.StoreKey,
 		supply.StoreKey, mint.StoreKey, distr.StoreKey, slashing.StoreKey,
-		gov.StoreKey, params.StoreKey, whitelist.StoreKey,
+		gov.StoreKey, params.StoreKey, whitelist.StoreKey, govwrap.StoreKey, upgrade.StoreKey,
+		poll.StoreKey,
 	)
 	tkeys := sdk.NewTransientStoreKeys(staking.TStoreKey, params.TStoreKey)
 
@@ -149,6 +161,8 @@ func NewLikeApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest b
 	govSubspace := app.paramsKeeper.Subspace(gov.DefaultParamspace)
 	crisisSubspace := app.paramsKeeper.Subspace(crisis.DefaultParamspace)
 	whitelistSubspace := app.paramsKeeper.Subspace(whitelist.DefaultParamspace)
+	govwrapSubspace := app.paramsKeeper.Subspace(govwrap.DefaultParamspace)
+	pollSubspace := app.paramsKeeper.Subspace(poll.DefaultParamspace)
 
 	// add keepers
 	app.accountKeeper = auth.NewAccountKeeper(app.cdc, keys[auth.StoreKey], authSubspace, auth.ProtoBaseAccount)
@@ -165,18 +179,27 @@ func NewLikeApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest b
 		app.cdc, keys[slashing.StoreKey], &stakingKeeper, slashingSubspace, slashing.DefaultCodespace,
 	)
 	app.crisisKeeper = crisis.NewKeeper(crisisSubspace, invCheckPeriod, app.supplyKeeper, auth.FeeCollectorName)
-	app.whitelistKeeper = whitelist.NewKeeper(app.cdc, keys[whitelist.StoreKey], whitelistSubspace, whitelist.DefaultCodespace)
+	app.whitelistKeeper = whitelist.NewKeeper(app.cdc, keys[whitelist.StoreKey], whitelistSubspace, &stakingKeeper, whitelist.DefaultCodespace)
+	app.upgradeKeeper = upgrade.NewKeeper(app.cdc, keys[upgrade.StoreKey], upgrade.DefaultCodespace)
+	app.registerUpgradeHandlers()
+	app.pollKeeper = poll.NewKeeper(app.cdc, keys[poll.StoreKey], pollSubspace, &stakingKeeper, poll.DefaultCodespace)
 
 	// register the proposal types
 	govRouter := gov.NewRouter()
 	govRouter.AddRoute(gov.RouterKey, gov.ProposalHandler).
 		AddRoute(params.RouterKey, params.NewParamChangeProposalHandler(app.paramsKeeper)).
-		AddRoute(distr.RouterKey, distr.NewCommunityPoolSpendProposalHandler(app.distrKeeper))
+		AddRoute(distr.RouterKey, distr.NewCommunityPoolSpendProposalHandler(app.distrKeeper)).
+		AddRoute(upgrade.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(app.upgradeKeeper))
 	app.govKeeper = gov.NewKeeper(
 		app.cdc, keys[gov.StoreKey], app.paramsKeeper, govSubspace,
 		app.supplyKeeper, &stakingKeeper, gov.DefaultCodespace, govRouter,
 	)
 
+	app.govwrapKeeper = govwrap.NewKeeper(
+		app.cdc, keys[govwrap.StoreKey], govwrapSubspace, &stakingKeeper,
+		app.govKeeper, keys[gov.StoreKey], govRouter, govwrap.DefaultCodespace,
+	)
+
 	// register the staking hooks
 	// NOTE: stakingKeeper above is passed by reference, so that it will contain these hooks
 	app.stakingKeeper = *stakingKeeper.SetHooks(
@@ -193,26 +216,31 @@ func NewLikeApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest b
 		crisis.NewAppModule(&app.crisisKeeper),
 		supply.NewAppModule(app.supplyKeeper, app.accountKeeper),
 		distr.NewAppModule(app.distrKeeper, app.supplyKeeper),
-		govwrap.NewAppModule(app.govKeeper, app.supplyKeeper, app.stakingKeeper),
+		govwrap.NewAppModule(app.govKeeper, app.supplyKeeper, app.govwrapKeeper),
 		mint.NewAppModule(app.mintKeeper),
 		slashing.NewAppModule(app.slashingKeeper, app.stakingKeeper),
 		stakingwrap.NewAppModule(app.stakingKeeper, app.distrKeeper, app.accountKeeper, app.supplyKeeper, app.whitelistKeeper),
 		whitelist.NewAppModule(app.whitelistKeeper),
+		upgrade.NewAppModule(app.upgradeKeeper),
+		poll.NewAppModule(app.pollKeeper),
 	)
 
+	// Upgrades are applied before any other module begins the block, so that they
+	// run on the migrated state.
 	// During begin block slashing happens after distr.BeginBlocker so that
 	// there is nothing left over in the validator fee pool, so as to keep the
 	// CanWithdrawInvariant invariant.
-	app.mm.SetOrderBeginBlockers(mint.ModuleName, distr.ModuleName, slashing.ModuleName)
+	app.mm.SetOrderBeginBlockers(upgrade.ModuleName, mint.ModuleName, distr.ModuleName, slashing.ModuleName)
 
-	app.mm.SetOrderEndBlockers(crisis.ModuleName, gov.ModuleName, staking.ModuleName)
+	app.mm.SetOrderEndBlockers(crisis.ModuleName, gov.ModuleName, poll.ModuleName, staking.ModuleName, whitelist.ModuleName)
 
 	// NOTE: The genutils module must occur after staking so that pools are
 	// properly initialized with tokens from genesis accounts.
 	app.mm.SetOrderInitGenesis(
 		genaccounts.ModuleName, distr.ModuleName, staking.ModuleName, whitelist.ModuleName,
 		auth.ModuleName, bank.ModuleName, slashing.ModuleName, gov.ModuleName,
-		mint.ModuleName, supply.ModuleName, crisis.ModuleName, genutil.ModuleName,
+		mint.ModuleName, supply.ModuleName, crisis.ModuleName, upgrade.ModuleName, poll.ModuleName,
+		genutil.ModuleName,
 	)
 
 	app.mm.RegisterInvariants(&app.crisisKeeper)
@@ -0,0 +1,13 @@
+package app
+
+// registerUpgradeHandlers registers the handlers of the software upgrades implemented by this binary.
+// When an upgrade proposal passes, the binary containing the handler with the plan name must be deployed before
+// the plan height, and nodes running older binaries halt at that height.
+//
+// Example:
+//
+//	app.upgradeKeeper.SetUpgradeHandler("v2", func(ctx sdk.Context, plan upgrade.Plan) {
+//		// state migrations
+//	})
+func (app *LikeApp) registerUpgradeHandlers() {
+}
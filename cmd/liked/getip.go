@@ -0,0 +1,239 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/server"
+
+	"github.com/likecoin/likechain/ip"
+	"github.com/likecoin/likechain/nat"
+)
+
+// get IP flags
+const flagGetIP = "get-ip"
+const flagGetIPFamily = "get-ip-family"
+const flagGetIPProvider = "get-ip-provider"
+const flagGetIPQuorum = "get-ip-quorum"
+const flagGetIPMinResponders = "get-ip-min-responders"
+const flagGetIPRetries = "get-ip-retries"
+const flagGetIPBackoff = "get-ip-backoff"
+const flagGetIPTimeout = "get-ip-timeout"
+
+// config.toml keys of the IP providers and the voting policy, e.g.
+//
+//	[get_ip]
+//	providers = ["ipv4,https://echo.example.com/,plain", "ipv6,https://echo.example.com/json,json,ip"]
+//	quorum = 2
+//	min_responders = 2
+//	retries = 2
+//	backoff = "1s"
+//	timeout = "10s"
+const configKeyGetIPProviders = "get_ip.providers"
+const configKeyGetIPQuorum = "get_ip.quorum"
+const configKeyGetIPMinResponders = "get_ip.min_responders"
+const configKeyGetIPRetries = "get_ip.retries"
+const configKeyGetIPBackoff = "get_ip.backoff"
+const configKeyGetIPTimeout = "get_ip.timeout"
+
+const ipFamilyAuto = "auto"
+
+var shouldGetIP bool
+var getIPFamily string
+var getIPProviders []string
+
+func addGetIPFlags(cmd *cobra.Command) {
+	cmd.PersistentFlags().BoolVar(&shouldGetIP, flagGetIP, false, "Get external IP for Tendermint")
+	cmd.PersistentFlags().StringVar(&getIPFamily, flagGetIPFamily, ipFamilyAuto,
+		"Address family of the external IP (ipv4|ipv6|auto), auto prefers IPv4 and falls back to IPv6")
+	cmd.PersistentFlags().StringArrayVar(&getIPProviders, flagGetIPProvider, nil,
+		"IP provider in the form of family,url,format[,field or pattern] with format plain, json, regex or stun, "+
+			"overriding get_ip.providers in config.toml, families without providers use the default providers")
+	policy := ip.DefaultPolicy()
+	cmd.PersistentFlags().Int(flagGetIPQuorum, policy.Quorum,
+		"Number of IP providers which must agree on the same IP, 0 for the majority, overriding get_ip.quorum")
+	cmd.PersistentFlags().Int(flagGetIPMinResponders, policy.MinResponders,
+		"Minimum number of IP providers which must answer a valid IP, overriding get_ip.min_responders")
+	cmd.PersistentFlags().Int(flagGetIPRetries, policy.Retries,
+		"Number of retries of a failed IP provider, overriding get_ip.retries")
+	cmd.PersistentFlags().Duration(flagGetIPBackoff, policy.Backoff,
+		"Delay before the first retry of an IP provider, doubled on each retry, overriding get_ip.backoff")
+	cmd.PersistentFlags().Duration(flagGetIPTimeout, policy.Timeout,
+		"Timeout of each query to an IP provider, overriding get_ip.timeout")
+}
+
+// ipFamilies returns the address families to discover in order of preference
+func ipFamilies(s string) ([]ip.Family, error) {
+	if s == ipFamilyAuto {
+		return ip.Families, nil
+	}
+	family, err := ip.ParseFamily(s)
+	if err != nil {
+		return nil, err
+	}
+	return []ip.Family{family}, nil
+}
+
+// ipProviders returns the providers in the flags, or in config.toml which is loaded into viper
+func ipProviders() (ip.Providers, error) {
+	configs := getIPProviders
+	if len(configs) == 0 {
+		configs = viper.GetStringSlice(configKeyGetIPProviders)
+	}
+	return ip.ParseProviders(configs)
+}
+
+// ipPolicy returns the voting policy in the flags set on the command line, falling back to config.toml and then the
+// default policy
+func ipPolicy(cmd *cobra.Command) (ip.Policy, error) {
+	policy := ip.DefaultPolicy()
+	flags := cmd.Flags()
+	var err error
+	for _, key := range []struct {
+		flag      string
+		configKey string
+		value     interface{}
+	}{
+		{flagGetIPQuorum, configKeyGetIPQuorum, &policy.Quorum},
+		{flagGetIPMinResponders, configKeyGetIPMinResponders, &policy.MinResponders},
+		{flagGetIPRetries, configKeyGetIPRetries, &policy.Retries},
+		{flagGetIPBackoff, configKeyGetIPBackoff, &policy.Backoff},
+		{flagGetIPTimeout, configKeyGetIPTimeout, &policy.Timeout},
+	} {
+		fromFlag := flags.Changed(key.flag)
+		if !fromFlag && !viper.IsSet(key.configKey) {
+			continue
+		}
+		switch value := key.value.(type) {
+		case *int:
+			if fromFlag {
+				*value, err = flags.GetInt(key.flag)
+			} else {
+				*value = viper.GetInt(key.configKey)
+			}
+		case *time.Duration:
+			if fromFlag {
+				*value, err = flags.GetDuration(key.flag)
+			} else {
+				*value = viper.GetDuration(key.configKey)
+			}
+		}
+		if err != nil {
+			return ip.Policy{}, err
+		}
+	}
+	err = policy.Validate()
+	if err != nil {
+		return ip.Policy{}, fmt.Errorf("invalid get IP policy: %s", err)
+	}
+	return policy, nil
+}
+
+// p2pPort returns the port of p2p.laddr
+func p2pPort(ctx *server.Context) (string, error) {
+	laddr, err := url.Parse(ctx.Config.P2P.ListenAddress)
+	if err != nil {
+		return "", errors.New("cannot parse p2p.laddr")
+	}
+	port := laddr.Port()
+	if port == "" {
+		return "", errors.New("cannot get port from p2p.laddr")
+	}
+	return port, nil
+}
+
+// discoverIPs runs the providers of the families in the flags with the configured policy, waiting for all providers if
+// waitForAll is set, and returns the families in order of preference with the report of each family
+func discoverIPs(cmd *cobra.Command, waitForAll bool) ([]ip.Family, map[ip.Family]*ip.Report, error) {
+	families, err := ipFamilies(getIPFamily)
+	if err != nil {
+		return nil, nil, err
+	}
+	providers, err := ipProviders()
+	if err != nil {
+		return nil, nil, err
+	}
+	policy, err := ipPolicy(cmd)
+	if err != nil {
+		return nil, nil, err
+	}
+	policy.WaitForAll = waitForAll
+	return families, ip.DiscoverIPs(providers, families, policy), nil
+}
+
+// agreedIPs returns the agreed IP of each family, and the agreed IP of the most preferred family
+func agreedIPs(families []ip.Family, reports map[ip.Family]*ip.Report) (map[ip.Family]string, string) {
+	ips := map[ip.Family]string{}
+	preferred := ""
+	for _, family := range families {
+		report := reports[family]
+		if report.Err != nil {
+			continue
+		}
+		ips[family] = report.IP
+		if preferred == "" {
+			preferred = report.IP
+		}
+	}
+	return ips, preferred
+}
+
+// setExternalAddress discovers the external IP and sets p2p.external_address with the external port of the port
+// mapping if any and the IP is an IPv4 address, and returns the discovered IPs. Failures of the discovery are reported
+// for each provider, and the last-known IP in the cache is used instead if it is within the TTL.
+func setExternalAddress(
+	ctx *server.Context, cmd *cobra.Command, mapping *nat.PortMapping,
+) (map[ip.Family]string, error) {
+	port, err := p2pPort(ctx)
+	if err != nil {
+		return nil, err
+	}
+	cacheTTL, err := ipCacheTTL(cmd)
+	if err != nil {
+		return nil, err
+	}
+	fmt.Println("getting external IP address")
+	families, reports, err := discoverIPs(cmd, false)
+	if err != nil {
+		return nil, err
+	}
+	for _, family := range families {
+		fmt.Println(reports[family])
+	}
+	ips, externalIP := agreedIPs(families, reports)
+	err = updateIPCache(ctx, ips)
+	if err != nil {
+		fmt.Printf("Cannot write external IP cache: %s\n", err)
+	}
+	if externalIP == "" {
+		for _, family := range families {
+			entry, ok := cachedIP(ctx, family, cacheTTL)
+			if !ok {
+				continue
+			}
+			fmt.Printf("WARNING: using the last-known %s address %s detected at %s\n",
+				family, entry.IP, entry.Time.Format(time.RFC3339))
+			ips[family] = entry.IP
+			externalIP = entry.IP
+			break
+		}
+	}
+	if externalIP == "" {
+		fmt.Println("Get IP failed, p2p.external_address is not set")
+		return ips, nil
+	}
+	// the gateway only maps the port of its IPv4 address, IPv6 addresses are reached on the p2p port directly
+	if mapping != nil && net.ParseIP(externalIP).To4() != nil {
+		port = strconv.Itoa(mapping.ExternalPort())
+	}
+	ctx.Config.P2P.ExternalAddress = ip.P2PAddress(externalIP, port)
+	fmt.Printf("p2p.external_address = %s\n", ctx.Config.P2P.ExternalAddress)
+	return ips, nil
+}
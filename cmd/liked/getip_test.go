@@ -0,0 +1,64 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+
+	"github.com/likecoin/likechain/ip"
+)
+
+// getIPCmd returns a command with the get IP flags parsed from the arguments, and sets the family and the providers
+// flags until they are restored
+func getIPCmd(t *testing.T, family string, providers []string, args ...string) (*cobra.Command, func()) {
+	cmd := &cobra.Command{}
+	addGetIPFlags(cmd)
+	addIPCacheFlags(cmd)
+	require.NoError(t, cmd.ParseFlags(args))
+	getIPFamily, getIPProviders = family, providers
+	return cmd, func() {
+		getIPFamily, getIPProviders = ipFamilyAuto, nil
+	}
+}
+
+func TestSetExternalAddress(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintln(w, "8.8.8.8")
+	}))
+	defer server.Close()
+	ctx, _, cleanup := testContext(t, "")
+	defer cleanup()
+
+	cmd, restore := getIPCmd(t, "ipv4", []string{"ipv4," + server.URL + ",plain"})
+	defer restore()
+	ips, err := setExternalAddress(ctx, cmd, nil)
+	require.NoError(t, err)
+	require.Equal(t, map[ip.Family]string{ip.IPv4: "8.8.8.8"}, ips)
+	require.Equal(t, "tcp://8.8.8.8:26656", ctx.Config.P2P.ExternalAddress)
+}
+
+func TestSetExternalAddressIPv6(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+	ctx, _, cleanup := testContext(t, "")
+	defer cleanup()
+
+	// the documentation address is rejected by the discovery, so it is set through the last-known IP in the cache
+	cache := &ip.Cache{IPs: map[ip.Family]ip.CacheEntry{}}
+	cache.Set(ip.IPv6, "2001:db8::1", time.Now())
+	require.NoError(t, cache.Save(ipCachePath(ctx)))
+
+	cmd, restore := getIPCmd(t, "ipv6", []string{"ipv6," + server.URL + ",plain"})
+	defer restore()
+	ips, err := setExternalAddress(ctx, cmd, nil)
+	require.NoError(t, err)
+	require.Equal(t, map[ip.Family]string{ip.IPv6: "2001:db8::1"}, ips)
+	require.Equal(t, "tcp://[2001:db8::1]:26656", ctx.Config.P2P.ExternalAddress)
+}
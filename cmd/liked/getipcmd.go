@@ -0,0 +1,163 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"github.com/tendermint/tendermint/libs/cli"
+
+	"github.com/cosmos/cosmos-sdk/server"
+
+	"github.com/likecoin/likechain/ip"
+)
+
+// get-ip command flags
+const flagWriteConfig = "write-config"
+
+// getIPResult is the JSON output of the get-ip command
+type getIPResult struct {
+	Reports         []*ip.Report `json:"reports"`
+	ExternalAddress string       `json:"external_address,omitempty"`
+	ConfigWritten   bool         `json:"config_written"`
+}
+
+// GetIPCmd runs the IP providers once and shows the result of each provider
+func GetIPCmd(ctx *server.Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "get-ip",
+		Short: "Detect the external IP address and show the answer of each IP provider",
+		Long: `Detect the external IP address with the providers and policy configured by the --get-ip-* flags or
+the [get_ip] section of config.toml, and show the answer of each provider and the agreed result.
+With --write-config, the agreed address is written into p2p.external_address of config.toml.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			output, err := cmd.Flags().GetString(cli.OutputFlag)
+			if err != nil {
+				return err
+			}
+			if output != "text" && output != "json" {
+				return fmt.Errorf("unknown output format: %s", output)
+			}
+			port, err := p2pPort(ctx)
+			if err != nil {
+				return err
+			}
+			// every provider is shown, including the ones answering after the quorum is reached
+			families, reports, err := discoverIPs(cmd, true)
+			if err != nil {
+				return err
+			}
+			// the IP cache is left to the start command, so that diagnosing does not change the fallback IP
+			_, externalIP := agreedIPs(families, reports)
+			result := getIPResult{}
+			for _, family := range families {
+				result.Reports = append(result.Reports, reports[family])
+			}
+			if externalIP != "" {
+				result.ExternalAddress = ip.P2PAddress(externalIP, port)
+				if writeConfig, _ := cmd.Flags().GetBool(flagWriteConfig); writeConfig {
+					err = writeExternalAddress(ctx, result.ExternalAddress)
+					if err != nil {
+						return err
+					}
+					result.ConfigWritten = true
+				}
+			}
+			if output == "json" {
+				bz, err := json.MarshalIndent(result, "", "  ")
+				if err != nil {
+					return err
+				}
+				fmt.Println(string(bz))
+			} else {
+				printGetIPResult(result)
+			}
+			if externalIP == "" {
+				return errors.New("no external IP address agreed by the providers")
+			}
+			return nil
+		},
+	}
+	cmd.Flags().StringP(cli.OutputFlag, "o", "text", "Output format (text|json)")
+	cmd.Flags().Bool(flagWriteConfig, false, "Write the agreed address into p2p.external_address of config.toml")
+	return cmd
+}
+
+func printGetIPResult(result getIPResult) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "FAMILY\tPROVIDER\tRESULT\tLATENCY\tATTEMPTS")
+	for _, report := range result.Reports {
+		for _, res := range report.Results {
+			answer := res.IP
+			if res.Err != nil {
+				answer = "error: " + res.Err.Error()
+			}
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%d\n",
+				report.Family, res.ServiceURL, answer, res.Latency, res.Attempts)
+		}
+	}
+	w.Flush()
+	fmt.Println()
+	for _, report := range result.Reports {
+		if report.Err != nil {
+			fmt.Printf("%s: %s\n", report.Family, report.Err)
+			continue
+		}
+		fmt.Printf("%s: %s (%d providers agreed, quorum %d)\n",
+			report.Family, report.IP, report.Votes()[report.IP], report.Quorum)
+	}
+	if result.ExternalAddress != "" {
+		fmt.Printf("p2p.external_address = %s\n", result.ExternalAddress)
+	}
+	if result.ConfigWritten {
+		fmt.Println("config.toml updated")
+	}
+}
+
+var tomlSectionRegexp = regexp.MustCompile(`^\s*\[([^\]]+)\]`)
+var tomlExternalAddressRegexp = regexp.MustCompile(`^(\s*external_address\s*=\s*)"[^"]*"(.*)$`)
+
+// writeExternalAddress replaces p2p.external_address in config.toml in place, keeping the rest of the file including
+// the sections unknown to Tendermint such as [get_ip]
+func writeExternalAddress(ctx *server.Context, addr string) error {
+	path := filepath.Join(ctx.Config.RootDir, "config", "config.toml")
+	bz, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	lines := strings.Split(string(bz), "\n")
+	section := ""
+	found := false
+	for i, line := range lines {
+		if match := tomlSectionRegexp.FindStringSubmatch(line); match != nil {
+			section = strings.TrimSpace(match[1])
+			continue
+		}
+		if section != "p2p" {
+			continue
+		}
+		if match := tomlExternalAddressRegexp.FindStringSubmatch(line); match != nil {
+			lines[i] = match[1] + strconv.Quote(addr) + match[2]
+			found = true
+			break
+		}
+	}
+	if !found {
+		return fmt.Errorf("cannot find p2p.external_address in %s", path)
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, []byte(strings.Join(lines, "\n")), info.Mode())
+}
@@ -0,0 +1,85 @@
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/server"
+)
+
+// testContext returns a context whose home contains config.toml with the content
+func testContext(t *testing.T, content string) (*server.Context, string, func()) {
+	home, err := ioutil.TempDir("", "liked")
+	require.NoError(t, err)
+	require.NoError(t, os.MkdirAll(filepath.Join(home, "config"), 0755))
+	path := filepath.Join(home, "config", "config.toml")
+	require.NoError(t, ioutil.WriteFile(path, []byte(content), 0600))
+	ctx := server.NewDefaultContext()
+	ctx.Config.RootDir = home
+	return ctx, path, func() { os.RemoveAll(home) }
+}
+
+func TestWriteExternalAddress(t *testing.T) {
+	ctx, path, cleanup := testContext(t, `# top comment
+moniker = "node"
+
+[get_ip]
+providers = ["ipv4,https://echo.example.com/,plain"]
+external_address = "unrelated"
+
+[p2p]
+laddr = "tcp://0.0.0.0:26656"
+  external_address = "" # set by liked
+
+[mempool]
+external_address = "unrelated"
+`)
+	defer cleanup()
+
+	require.NoError(t, writeExternalAddress(ctx, "8.8.8.8:26656"))
+	bz, err := ioutil.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, `# top comment
+moniker = "node"
+
+[get_ip]
+providers = ["ipv4,https://echo.example.com/,plain"]
+external_address = "unrelated"
+
+[p2p]
+laddr = "tcp://0.0.0.0:26656"
+  external_address = "8.8.8.8:26656" # set by liked
+
+[mempool]
+external_address = "unrelated"
+`, string(bz))
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	require.Equal(t, os.FileMode(0600), info.Mode())
+
+	// an existing address is replaced
+	require.NoError(t, writeExternalAddress(ctx, "[2606:4700::1]:26656"))
+	bz, err = ioutil.ReadFile(path)
+	require.NoError(t, err)
+	require.Contains(t, string(bz), `  external_address = "[2606:4700::1]:26656" # set by liked`)
+}
+
+func TestWriteExternalAddressMissingKey(t *testing.T) {
+	content := `[get_ip]
+external_address = "unrelated"
+
+[p2p]
+laddr = "tcp://0.0.0.0:26656"
+`
+	ctx, path, cleanup := testContext(t, content)
+	defer cleanup()
+
+	require.Error(t, writeExternalAddress(ctx, "8.8.8.8:26656"))
+	bz, err := ioutil.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, content, string(bz))
+}
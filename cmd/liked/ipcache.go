@@ -0,0 +1,112 @@
+package main
+
+import (
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/server"
+
+	"github.com/likecoin/likechain/ip"
+)
+
+// external IP cache flags
+const flagGetIPCacheTTL = "get-ip-cache-ttl"
+
+// config.toml key of the external IP cache TTL, e.g.
+//
+//	[get_ip]
+//	cache_ttl = "24h"
+const configKeyGetIPCacheTTL = "get_ip.cache_ttl"
+
+const defaultIPCacheTTL = 24 * time.Hour
+
+func addIPCacheFlags(cmd *cobra.Command) {
+	cmd.PersistentFlags().Duration(flagGetIPCacheTTL, defaultIPCacheTTL,
+		"Maximum age of the last-known external IP used when the IP providers fail, 0 to disable, "+
+			"overriding get_ip.cache_ttl")
+}
+
+// ipCachePath returns the path of the external IP cache in the data directory of the node home
+func ipCachePath(ctx *server.Context) string {
+	return filepath.Join(ctx.Config.DBDir(), ip.CacheFileName)
+}
+
+// ipCacheTTL returns the cache TTL in the flag set on the command line, falling back to config.toml and then the
+// default TTL
+func ipCacheTTL(cmd *cobra.Command) (time.Duration, error) {
+	if cmd.Flags().Changed(flagGetIPCacheTTL) {
+		return cmd.Flags().GetDuration(flagGetIPCacheTTL)
+	}
+	if viper.IsSet(configKeyGetIPCacheTTL) {
+		return viper.GetDuration(configKeyGetIPCacheTTL), nil
+	}
+	return defaultIPCacheTTL, nil
+}
+
+// updateIPCache records the discovered IPs in the cache, an unreadable cache is overwritten
+func updateIPCache(ctx *server.Context, ips map[ip.Family]string) error {
+	if len(ips) == 0 {
+		return nil
+	}
+	path := ipCachePath(ctx)
+	cache, err := ip.LoadCache(path)
+	if err != nil {
+		cache = &ip.Cache{IPs: map[ip.Family]ip.CacheEntry{}}
+	}
+	now := time.Now()
+	for family, externalIP := range ips {
+		cache.Set(family, externalIP, now)
+	}
+	return cache.Save(path)
+}
+
+// cachedIP returns the last-known IP of the family if it is within the TTL
+func cachedIP(ctx *server.Context, family ip.Family, ttl time.Duration) (ip.CacheEntry, bool) {
+	cache, err := ip.LoadCache(ipCachePath(ctx))
+	if err != nil {
+		fmt.Printf("Cannot read external IP cache: %s\n", err)
+		return ip.CacheEntry{}, false
+	}
+	return cache.Get(family, ttl, time.Now())
+}
+
+// ShowExternalIPCmd shows the last-known external IPs in the cache
+func ShowExternalIPCmd(ctx *server.Context) *cobra.Command {
+	return &cobra.Command{
+		Use:   "show-external-ip",
+		Short: "Show the last-known external IP addresses detected by --get-ip",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			ttl, err := ipCacheTTL(cmd)
+			if err != nil {
+				return err
+			}
+			cache, err := ip.LoadCache(ipCachePath(ctx))
+			if err != nil {
+				return err
+			}
+			if len(cache.IPs) == 0 {
+				fmt.Println("No external IP address detected yet")
+				return nil
+			}
+			now := time.Now()
+			for _, family := range ip.Families {
+				entry, ok := cache.IPs[family]
+				if !ok {
+					continue
+				}
+				status := "valid"
+				if entry.Expired(ttl, now) {
+					status = "expired"
+				}
+				fmt.Printf("%s: %s (detected at %s, %s ago, %s)\n", family, entry.IP,
+					entry.Time.Format(time.RFC3339), now.Sub(entry.Time).Round(time.Second), status)
+			}
+			return nil
+		},
+	}
+}
@@ -0,0 +1,94 @@
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/likecoin/likechain/ip"
+)
+
+// ipServer returns a provider answering the IP, or failing if the IP is empty
+func ipServer(externalIP string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if externalIP == "" {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		fmt.Fprintln(w, externalIP)
+	}))
+}
+
+func TestIPCacheFallback(t *testing.T) {
+	server := ipServer("")
+	defer server.Close()
+	providers := []string{"ipv4," + server.URL + ",plain"}
+
+	for _, tc := range []struct {
+		name     string
+		age      time.Duration
+		args     []string
+		expected string
+	}{
+		{"within default TTL", time.Hour, nil, "tcp://8.8.4.4:26656"},
+		{"within TTL", time.Hour, []string{"--get-ip-cache-ttl=2h"}, "tcp://8.8.4.4:26656"},
+		{"expired", time.Hour, []string{"--get-ip-cache-ttl=30m"}, ""},
+		{"disabled", 0, []string{"--get-ip-cache-ttl=0"}, ""},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, _, cleanup := testContext(t, "")
+			defer cleanup()
+			cache := &ip.Cache{IPs: map[ip.Family]ip.CacheEntry{}}
+			cache.Set(ip.IPv4, "8.8.4.4", time.Now().Add(-tc.age))
+			require.NoError(t, cache.Save(ipCachePath(ctx)))
+
+			cmd, restore := getIPCmd(t, "ipv4", providers, tc.args...)
+			defer restore()
+			ips, err := setExternalAddress(ctx, cmd, nil)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, ctx.Config.P2P.ExternalAddress)
+			if tc.expected == "" {
+				require.Empty(t, ips)
+			} else {
+				require.Equal(t, map[ip.Family]string{ip.IPv4: "8.8.4.4"}, ips)
+			}
+		})
+	}
+}
+
+func TestIPCacheCorrupt(t *testing.T) {
+	ctx, _, cleanup := testContext(t, "")
+	defer cleanup()
+	path := ipCachePath(ctx)
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0700))
+	require.NoError(t, ioutil.WriteFile(path, []byte("not json"), 0600))
+
+	// an unreadable cache is not used
+	failing := ipServer("")
+	defer failing.Close()
+	cmd, restore := getIPCmd(t, "ipv4", []string{"ipv4," + failing.URL + ",plain"})
+	defer restore()
+	ips, err := setExternalAddress(ctx, cmd, nil)
+	require.NoError(t, err)
+	require.Empty(t, ips)
+	require.Empty(t, ctx.Config.P2P.ExternalAddress)
+
+	// and it is overwritten by the next detected IP
+	server := ipServer("8.8.8.8")
+	defer server.Close()
+	cmd, restore = getIPCmd(t, "ipv4", []string{"ipv4," + server.URL + ",plain"})
+	defer restore()
+	_, err = setExternalAddress(ctx, cmd, nil)
+	require.NoError(t, err)
+	require.Equal(t, "tcp://8.8.8.8:26656", ctx.Config.P2P.ExternalAddress)
+	entry, ok := cachedIP(ctx, ip.IPv4, time.Hour)
+	require.True(t, ok)
+	require.Equal(t, "8.8.8.8", entry.IP)
+}
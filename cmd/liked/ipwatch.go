@@ -0,0 +1,73 @@
+package main
+
+import (
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/server"
+
+	"github.com/likecoin/likechain/ip"
+)
+
+// external IP watcher flags
+const flagGetIPInterval = "get-ip-interval"
+const flagGetIPRestart = "get-ip-restart"
+
+var getIPInterval time.Duration
+var getIPRestart bool
+
+// ipWatcher re-detects the external IPs while the node started in-process is running, if enabled
+var ipWatcher *ip.Watcher
+
+func addIPWatcherFlags(cmd *cobra.Command) {
+	cmd.PersistentFlags().DurationVar(&getIPInterval, flagGetIPInterval, 0,
+		"Interval of re-detecting the external IP when the node is started with --get-ip, disabled if 0")
+	cmd.PersistentFlags().BoolVar(&getIPRestart, flagGetIPRestart, false,
+		"Stop the node gracefully and exit with an error when the external IP changes, for the process supervisor "+
+			"to restart it with the new p2p.external_address")
+}
+
+// startIPWatcher starts re-detecting the external IPs for the start command running Tendermint, with metrics exposed on
+// the Prometheus endpoint of Tendermint if instrumentation is enabled. The watcher is stopped by the start command.
+func startIPWatcher(ctx *server.Context, cmd *cobra.Command, ips map[ip.Family]string) (*ip.Watcher, error) {
+	if getIPInterval <= 0 || cmd.Name() != "start" || !viper.GetBool(flagWithTendermint) {
+		return nil, nil
+	}
+	families, err := ipFamilies(getIPFamily)
+	if err != nil {
+		return nil, err
+	}
+	providers, err := ipProviders()
+	if err != nil {
+		return nil, err
+	}
+	policy, err := ipPolicy(cmd)
+	if err != nil {
+		return nil, err
+	}
+	metrics := ip.NopMetrics()
+	if ctx.Config.Instrumentation.Prometheus {
+		metrics = ip.PrometheusMetrics(ctx.Config.Instrumentation.Namespace)
+	}
+	logger := ctx.Logger.With("module", "external-ip")
+	onChange := func(family ip.Family, oldIP, newIP string) {
+		err := updateIPCache(ctx, map[ip.Family]string{family: newIP})
+		if err != nil {
+			logger.Error("cannot write external IP cache", "err", err)
+		}
+		if !getIPRestart {
+			logger.Info("p2p.external_address is outdated until the node is restarted")
+			return
+		}
+		logger.Info("stopping the node for restarting with the new external IP")
+		requestRestart("external IP changed")
+	}
+	watcher := ip.NewWatcher(providers, families, policy, getIPInterval, logger, metrics, onChange)
+	for family, externalIP := range ips {
+		watcher.SetIP(family, externalIP)
+	}
+	watcher.Start()
+	return watcher, nil
+}
@@ -2,10 +2,7 @@ package main
 
 import (
 	"encoding/json"
-	"errors"
-	"fmt"
 	"io"
-	"net/url"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -27,16 +24,12 @@ import (
 	genaccscli "github.com/cosmos/cosmos-sdk/x/genaccounts/client/cli"
 	genutilcli "github.com/cosmos/cosmos-sdk/x/genutil/client/cli"
 	"github.com/cosmos/cosmos-sdk/x/staking"
-
-	"github.com/likecoin/likechain/ip"
 )
 
 // liked custom flags
 const flagInvCheckPeriod = "inv-check-period"
-const flagGetIP = "get-ip"
 
 var invCheckPeriod uint
-var shouldGetIP bool
 
 func persistentPreRunEFn(ctx *server.Context) func(cmd *cobra.Command, args []string) error {
 	originalFn := server.PersistentPreRunEFn(ctx)
@@ -45,24 +38,17 @@ func persistentPreRunEFn(ctx *server.Context) func(cmd *cobra.Command, args []st
 		if err != nil {
 			return err
 		}
+		portMapping = startPortMapping(ctx, cmd)
 		if shouldGetIP {
-			laddr, err := url.Parse(ctx.Config.P2P.ListenAddress)
-			if err != nil {
-				return errors.New("cannot parse p2p.laddr")
-			}
-			port := laddr.Port()
-			if port == "" {
-				return errors.New("cannot get port from p2p.laddr")
-			}
-			fmt.Println("getting external IP address")
-			ip, err := ip.RunProviders(ip.IPGetters, ip.DefaultTimeout)
+			ips, err := setExternalAddress(ctx, cmd, portMapping)
 			if err != nil {
-				fmt.Println("Get IP failed, ignoring")
-				return nil
+				return err
 			}
-			fmt.Printf("Got external IP: %s\n", ip)
-			ctx.Config.P2P.ExternalAddress = fmt.Sprintf("tcp://%s:%s", ip, laddr.Port())
-			fmt.Printf("p2p.external_address = %s\n", ctx.Config.P2P.ExternalAddress)
+			ipWatcher, err = startIPWatcher(ctx, cmd, ips)
+			return err
+		}
+		if portMapping != nil {
+			setGatewayExternalAddress(ctx, portMapping)
 		}
 		return nil
 	}
@@ -92,10 +78,16 @@ func main() {
 		genaccounts.AppModuleBasic{}, app.DefaultNodeHome, app.DefaultCLIHome))
 	rootCmd.AddCommand(genutilcli.ValidateGenesisCmd(ctx, cdc, app.ModuleBasics))
 	rootCmd.AddCommand(genaccscli.AddGenesisAccountCmd(ctx, cdc, app.DefaultNodeHome, app.DefaultCLIHome))
+	rootCmd.AddCommand(GetIPCmd(ctx))
+	rootCmd.AddCommand(ShowExternalIPCmd(ctx))
 	rootCmd.AddCommand(client.NewCompletionCmd(rootCmd, true))
 
 	server.AddCommands(ctx, cdc, rootCmd, newApp, exportAppStateAndTMValidators)
-	rootCmd.PersistentFlags().BoolVar(&shouldGetIP, flagGetIP, false, "Get external IP for Tendermint")
+	wrapStartCmd(ctx, rootCmd, newApp)
+	addGetIPFlags(rootCmd)
+	addNATFlags(rootCmd)
+	addIPWatcherFlags(rootCmd)
+	addIPCacheFlags(rootCmd)
 
 	// prepare and add flags
 	executor := cli.PrepareBaseCmd(rootCmd, "GA", app.DefaultNodeHome)
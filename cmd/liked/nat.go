@@ -0,0 +1,93 @@
+package main
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/server"
+
+	"github.com/likecoin/likechain/ip"
+	"github.com/likecoin/likechain/nat"
+)
+
+// NAT port mapping flags
+const flagNAT = "nat"
+const flagNATLifetime = "nat-lifetime"
+const flagNATGateway = "nat-gateway"
+
+var natProtocol string
+var natLifetime time.Duration
+var natGateway string
+
+func addNATFlags(cmd *cobra.Command) {
+	cmd.PersistentFlags().StringVar(&natProtocol, flagNAT, "",
+		"Map the p2p.laddr port on the gateway when starting the node (upnp|natpmp|auto), disabled if empty")
+	cmd.PersistentFlags().DurationVar(&natLifetime, flagNATLifetime, time.Hour,
+		"Lifetime of the port mapping, which is renewed every half of the lifetime, at least 2s")
+	cmd.PersistentFlags().StringVar(&natGateway, flagNATGateway, "",
+		"IPv4 address of the NAT-PMP gateway, the default gateway is used if empty")
+}
+
+// startPortMapping maps the p2p port on the gateway for the start command, the mapping is removed by the start command
+// on SIGINT and SIGTERM. Failures are ignored and nil is returned.
+func startPortMapping(ctx *server.Context, cmd *cobra.Command) *nat.PortMapping {
+	if natProtocol == "" || cmd.Name() != "start" {
+		return nil
+	}
+	logger := ctx.Logger.With("module", "nat")
+	if natLifetime < nat.MinLifetime {
+		logger.Error("NAT mapping lifetime must be at least 2s, ignoring", "lifetime", natLifetime)
+		return nil
+	}
+	port, err := p2pPort(ctx)
+	if err != nil {
+		logger.Error("cannot map p2p port", "err", err)
+		return nil
+	}
+	internalPort, err := strconv.Atoi(port)
+	if err != nil {
+		logger.Error("cannot map p2p port", "err", err)
+		return nil
+	}
+	var gateway net.IP
+	if natGateway != "" {
+		gateway = net.ParseIP(natGateway)
+		if gateway == nil || gateway.To4() == nil {
+			logger.Error("invalid NAT gateway", "gateway", natGateway)
+			return nil
+		}
+	}
+	mapper, err := nat.Discover(natProtocol, gateway)
+	if err != nil {
+		logger.Error("cannot discover NAT gateway, ignoring", "err", err)
+		return nil
+	}
+	mapping, err := nat.MapPort(mapper, internalPort, natLifetime, logger)
+	if err != nil {
+		logger.Error("cannot map p2p port, ignoring", "err", err)
+		return nil
+	}
+	return mapping
+}
+
+// setGatewayExternalAddress sets p2p.external_address using the external IP reported by the gateway and the mapped
+// port, unless the external address is configured
+func setGatewayExternalAddress(ctx *server.Context, mapping *nat.PortMapping) {
+	if ctx.Config.P2P.ExternalAddress != "" {
+		return
+	}
+	externalIP, err := mapping.ExternalIP()
+	if err == nil {
+		_, err = ip.ValidateIP(ip.IPv4, externalIP.String())
+	}
+	if err != nil {
+		fmt.Printf("Get external IP from gateway failed, ignoring: %s\n", err)
+		return
+	}
+	ctx.Config.P2P.ExternalAddress = ip.P2PAddress(externalIP.String(), strconv.Itoa(mapping.ExternalPort()))
+	fmt.Printf("p2p.external_address = %s\n", ctx.Config.P2P.ExternalAddress)
+}
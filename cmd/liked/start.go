@@ -0,0 +1,154 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime/pprof"
+	"sync"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/tendermint/tendermint/node"
+	"github.com/tendermint/tendermint/p2p"
+	pvm "github.com/tendermint/tendermint/privval"
+	"github.com/tendermint/tendermint/proxy"
+
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/likecoin/likechain/ip"
+	"github.com/likecoin/likechain/nat"
+)
+
+// start flags of the SDK start command
+const flagWithTendermint = "with-tendermint"
+const flagTraceStore = "trace-store"
+const flagCPUProfile = "cpu-profile"
+
+// portMapping is the mapping of the p2p port created before the start command runs, if any
+var portMapping *nat.PortMapping
+
+// restartRequests receives the reason of stopping the node for the process supervisor to restart it
+var restartRequests = make(chan string, 1)
+
+// requestRestart makes the node started in-process stop gracefully and exit with an error, further requests are
+// dropped while the first one is handled
+func requestRestart(reason string) {
+	select {
+	case restartRequests <- reason:
+	default:
+	}
+}
+
+// wrapStartCmd makes the start command run the node in-process by itself when the p2p port is mapped or the external IP
+// is watched, since the signal handler of the SDK exits right after stopping Tendermint, before the mapping could be
+// removed, and the node cannot be stopped on request
+func wrapStartCmd(ctx *server.Context, rootCmd *cobra.Command, appCreator server.AppCreator) {
+	startCmd, _, err := rootCmd.Find([]string{"start"})
+	if err != nil {
+		panic(err)
+	}
+	runE := startCmd.RunE
+	startCmd.RunE = func(cmd *cobra.Command, args []string) error {
+		if (portMapping == nil && ipWatcher == nil) || !viper.GetBool(flagWithTendermint) {
+			return runE(cmd, args)
+		}
+		ctx.Logger.Info("starting ABCI with Tendermint")
+		return startInProcess(ctx, appCreator, portMapping, ipWatcher)
+	}
+}
+
+// startInProcess follows the in-process start of the SDK. The IP watcher and the port mapping, each of which may be
+// nil, are stopped after stopping Tendermint on SIGINT and SIGTERM, or on a restart request, after which an error is
+// returned.
+func startInProcess(
+	ctx *server.Context, appCreator server.AppCreator, mapping *nat.PortMapping, watcher *ip.Watcher,
+) error {
+	cfg := ctx.Config
+
+	db, err := sdk.NewLevelDB("application", filepath.Join(cfg.RootDir, "data"))
+	if err != nil {
+		return err
+	}
+	var traceWriter io.Writer
+	if traceWriterFile := viper.GetString(flagTraceStore); traceWriterFile != "" {
+		traceWriter, err = os.OpenFile(traceWriterFile, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0666)
+		if err != nil {
+			return err
+		}
+	}
+
+	app := appCreator(ctx.Logger, db, traceWriter)
+
+	nodeKey, err := p2p.LoadOrGenNodeKey(cfg.NodeKeyFile())
+	if err != nil {
+		return err
+	}
+
+	server.UpgradeOldPrivValFile(cfg)
+
+	tmNode, err := node.NewNode(
+		cfg,
+		pvm.LoadOrGenFilePV(cfg.PrivValidatorKeyFile(), cfg.PrivValidatorStateFile()),
+		nodeKey,
+		proxy.NewLocalClientCreator(app),
+		node.DefaultGenesisDocProviderFunc(cfg),
+		node.DefaultDBProvider,
+		node.DefaultMetricsProvider(cfg.Instrumentation),
+		ctx.Logger.With("module", "node"),
+	)
+	if err != nil {
+		return err
+	}
+
+	if err := tmNode.Start(); err != nil {
+		return err
+	}
+
+	var cpuProfileCleanup func()
+	if cpuProfile := viper.GetString(flagCPUProfile); cpuProfile != "" {
+		f, err := os.Create(cpuProfile)
+		if err != nil {
+			return err
+		}
+		ctx.Logger.Info("starting CPU profiler", "profile", cpuProfile)
+		if err := pprof.StartCPUProfile(f); err != nil {
+			return err
+		}
+		cpuProfileCleanup = func() {
+			ctx.Logger.Info("stopping CPU profiler", "profile", cpuProfile)
+			pprof.StopCPUProfile()
+			f.Close()
+		}
+	}
+
+	var cleanupOnce sync.Once
+	cleanup := func() {
+		cleanupOnce.Do(func() {
+			if tmNode.IsRunning() {
+				_ = tmNode.Stop()
+			}
+			if watcher != nil {
+				watcher.Stop()
+			}
+			if mapping != nil {
+				if err := mapping.Close(); err != nil {
+					ctx.Logger.Error("cannot remove port mapping", "module", "nat", "err", err)
+				}
+			}
+			if cpuProfileCleanup != nil {
+				cpuProfileCleanup()
+			}
+			ctx.Logger.Info("exiting...")
+		})
+	}
+	server.TrapSignal(cleanup)
+
+	// run until a signal exits the process or a restart is requested
+	reason := <-restartRequests
+	cleanup()
+	return fmt.Errorf("node stopped for restarting: %s", reason)
+}
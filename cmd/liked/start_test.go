@@ -0,0 +1,15 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRequestRestart(t *testing.T) {
+	// the requests made while the first one is pending are dropped without blocking the watcher
+	requestRestart("first")
+	requestRestart("second")
+	require.Equal(t, "first", <-restartRequests)
+	require.Empty(t, restartRequests)
+}
@@ -0,0 +1,92 @@
+package ip
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// CacheFileName is the name of the cache file in the data directory of the node home
+const CacheFileName = "external_ip.json"
+
+// CacheEntry is the last IP of a family agreed by the providers
+type CacheEntry struct {
+	IP   string    `json:"ip"`
+	Time time.Time `json:"time"`
+}
+
+// Expired returns true if the entry is older than the TTL, a TTL of 0 disables the cache
+func (e CacheEntry) Expired(ttl time.Duration, now time.Time) bool {
+	return ttl <= 0 || now.Sub(e.Time) > ttl
+}
+
+// Cache is the last-known IPs persisted across restarts, used when the providers fail
+type Cache struct {
+	IPs map[Family]CacheEntry `json:"ips"`
+}
+
+// LoadCache reads the cache file, a missing file is an empty cache
+func LoadCache(path string) (*Cache, error) {
+	cache := &Cache{IPs: map[Family]CacheEntry{}}
+	bz, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return cache, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	err = json.Unmarshal(bz, cache)
+	if err != nil {
+		return nil, err
+	}
+	if cache.IPs == nil {
+		cache.IPs = map[Family]CacheEntry{}
+	}
+	return cache, nil
+}
+
+// Save writes the cache file atomically
+func (c *Cache) Save(path string) error {
+	bz, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return err
+	}
+	dir := filepath.Dir(path)
+	err = os.MkdirAll(dir, 0700)
+	if err != nil {
+		return err
+	}
+	f, err := ioutil.TempFile(dir, CacheFileName)
+	if err != nil {
+		return err
+	}
+	_, err = f.Write(bz)
+	if err == nil {
+		err = f.Sync()
+	}
+	closeErr := f.Close()
+	if err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(f.Name())
+		return err
+	}
+	return os.Rename(f.Name(), path)
+}
+
+// Get returns the cached IP of the family if it is not expired
+func (c *Cache) Get(family Family, ttl time.Duration, now time.Time) (CacheEntry, bool) {
+	entry, ok := c.IPs[family]
+	if !ok || entry.Expired(ttl, now) {
+		return CacheEntry{}, false
+	}
+	return entry, true
+}
+
+// Set records the IP of the family agreed by the providers at the time
+func (c *Cache) Set(family Family, ip string, now time.Time) {
+	c.IPs[family] = CacheEntry{IP: ip, Time: now.UTC()}
+}
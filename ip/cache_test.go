@@ -0,0 +1,67 @@
+package ip
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func tempCachePath(t *testing.T) (string, func()) {
+	dir, err := ioutil.TempDir("", "ipcache")
+	require.NoError(t, err)
+	return filepath.Join(dir, "data", CacheFileName), func() { os.RemoveAll(dir) }
+}
+
+func TestCacheSaveLoad(t *testing.T) {
+	path, cleanup := tempCachePath(t)
+	defer cleanup()
+
+	// a missing file is an empty cache
+	cache, err := LoadCache(path)
+	require.NoError(t, err)
+	require.Empty(t, cache.IPs)
+
+	now := time.Unix(1500000000, 0)
+	cache.Set(IPv4, "8.8.8.8", now)
+	cache.Set(IPv6, "2606:4700::1111", now)
+	require.NoError(t, cache.Save(path))
+	loaded, err := LoadCache(path)
+	require.NoError(t, err)
+	require.Equal(t, cache, loaded)
+
+	files, err := ioutil.ReadDir(filepath.Dir(path))
+	require.NoError(t, err)
+	require.Len(t, files, 1, "temporary files are left")
+}
+
+func TestCacheTTL(t *testing.T) {
+	cache := &Cache{IPs: map[Family]CacheEntry{}}
+	now := time.Unix(1500000000, 0)
+	cache.Set(IPv4, "8.8.8.8", now)
+
+	entry, ok := cache.Get(IPv4, time.Hour, now.Add(time.Hour))
+	require.True(t, ok)
+	require.Equal(t, "8.8.8.8", entry.IP)
+	_, ok = cache.Get(IPv4, time.Hour, now.Add(time.Hour+time.Second))
+	require.False(t, ok)
+	_, ok = cache.Get(IPv6, time.Hour, now)
+	require.False(t, ok)
+
+	// a TTL of 0 disables the cache, even for an IP detected right now
+	_, ok = cache.Get(IPv4, 0, now)
+	require.False(t, ok)
+}
+
+func TestLoadCorruptCache(t *testing.T) {
+	path, cleanup := tempCachePath(t)
+	defer cleanup()
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0700))
+	require.NoError(t, ioutil.WriteFile(path, []byte(`{"ips": {"ipv4": `), 0600))
+
+	_, err := LoadCache(path)
+	require.Error(t, err)
+}
@@ -0,0 +1,136 @@
+package ip
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// provider response formats
+const (
+	FormatPlain = "plain"
+	FormatJSON  = "json"
+	FormatRegex = "regex"
+	FormatSTUN  = "stun"
+)
+
+// Providers are the IP getters of each address family
+type Providers map[Family][]IPGetter
+
+// DefaultProviders returns the compiled-in providers
+func DefaultProviders() Providers {
+	return Providers{
+		IPv4: IPv4Getters,
+		IPv6: IPv6Getters,
+	}
+}
+
+// ProviderConfig is a provider configured by the operator
+type ProviderConfig struct {
+	Family Family
+	URL    string
+	Format string
+	// the JSON field for the json format, or the pattern for the regex format, whose first submatch is the IP if any
+	Arg string
+}
+
+// ParseProviderConfig parses a provider in the form of "family,url,format[,field or pattern]", e.g.
+// "ipv4,https://echo.example.com/,plain", "ipv6,https://echo.example.com/json,json,ip" or
+// "ipv4,https://echo.example.com/html,regex,Address: ([0-9.]+)", or a STUN server such as
+// "ipv4,stun:stun.example.com:3478,stun"
+func ParseProviderConfig(s string) (ProviderConfig, error) {
+	parts := strings.SplitN(strings.TrimSpace(s), ",", 4)
+	if len(parts) < 3 {
+		return ProviderConfig{}, fmt.Errorf("invalid IP provider %q, expected family,url,format[,field or pattern]", s)
+	}
+	family, err := ParseFamily(strings.TrimSpace(parts[0]))
+	if err != nil {
+		return ProviderConfig{}, err
+	}
+	config := ProviderConfig{
+		Family: family,
+		URL:    strings.TrimSpace(parts[1]),
+		Format: strings.TrimSpace(parts[2]),
+	}
+	if len(parts) == 4 {
+		config.Arg = parts[3]
+	}
+	return config, nil
+}
+
+// IPGetter returns the getter of the provider
+func (config ProviderConfig) IPGetter() (IPGetter, error) {
+	getter := IPGetter{ServiceURL: config.URL}
+	if config.Format == FormatSTUN {
+		if _, err := stunServerAddr(config.URL); err != nil {
+			return IPGetter{}, err
+		}
+		getter.GetIP = STUNGetIP
+		return getter, nil
+	}
+	if !strings.HasPrefix(config.URL, "http://") && !strings.HasPrefix(config.URL, "https://") {
+		return IPGetter{}, fmt.Errorf("invalid IP provider URL %q", config.URL)
+	}
+	switch config.Format {
+	case FormatPlain:
+		getter.GetIP = HTTPGetString
+	case FormatJSON:
+		if config.Arg == "" {
+			return IPGetter{}, fmt.Errorf("missing JSON field for IP provider %s", config.URL)
+		}
+		getter.GetIP = HTTPJSONGetField(config.Arg)
+	case FormatRegex:
+		re, err := regexp.Compile(config.Arg)
+		if err != nil {
+			return IPGetter{}, fmt.Errorf("invalid pattern for IP provider %s: %s", config.URL, err)
+		}
+		getter.GetIP = HTTPRegexGetMatch(re)
+	default:
+		return IPGetter{}, fmt.Errorf("unknown format %q for IP provider %s", config.Format, config.URL)
+	}
+	return getter, nil
+}
+
+// ParseProviders parses the configured providers, families without configured providers fall back to the defaults
+func ParseProviders(configs []string) (Providers, error) {
+	providers := Providers{}
+	for _, s := range configs {
+		if strings.TrimSpace(s) == "" {
+			continue
+		}
+		config, err := ParseProviderConfig(s)
+		if err != nil {
+			return nil, err
+		}
+		getter, err := config.IPGetter()
+		if err != nil {
+			return nil, err
+		}
+		providers[config.Family] = append(providers[config.Family], getter)
+	}
+	for family, getters := range DefaultProviders() {
+		if len(providers[family]) == 0 {
+			providers[family] = getters
+		}
+	}
+	return providers, nil
+}
+
+// HTTPRegexGetMatch returns a getter matching the body with the pattern, using the first submatch if any
+func HTTPRegexGetMatch(re *regexp.Regexp) func(string, context.Context) (string, error) {
+	return func(url string, ctx context.Context) (string, error) {
+		body, err := HTTPGetBody(url, ctx)
+		if err != nil {
+			return "", err
+		}
+		match := re.FindSubmatch(body)
+		if match == nil {
+			return "", fmt.Errorf("response does not match pattern %s", re)
+		}
+		if len(match) > 1 {
+			return string(match[1]), nil
+		}
+		return string(match[0]), nil
+	}
+}
@@ -0,0 +1,133 @@
+package ip
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseProviderConfig(t *testing.T) {
+	tests := []struct {
+		name   string
+		s      string
+		config ProviderConfig
+		valid  bool
+	}{
+		{"plain", "ipv4,https://echo.example.com/,plain", ProviderConfig{IPv4, "https://echo.example.com/", FormatPlain, ""}, true},
+		{"json", " ipv6 , https://echo.example.com/json , json,ip", ProviderConfig{IPv6, "https://echo.example.com/json", FormatJSON, "ip"}, true},
+		// the pattern is kept as is, including commas and spaces
+		{"regex", "ipv4,https://echo.example.com/html,regex,Address: ([0-9.]+), port", ProviderConfig{IPv4, "https://echo.example.com/html", FormatRegex, "Address: ([0-9.]+), port"}, true},
+		{"stun", "ipv4,stun:stun.example.com:3478,stun", ProviderConfig{IPv4, "stun:stun.example.com:3478", FormatSTUN, ""}, true},
+		{"missing format", "ipv4,https://echo.example.com/", ProviderConfig{}, false},
+		{"unknown family", "ipv5,https://echo.example.com/,plain", ProviderConfig{}, false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			config, err := ParseProviderConfig(test.s)
+			if !test.valid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, test.config, config)
+		})
+	}
+}
+
+func TestProviderConfigIPGetter(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/plain":
+			fmt.Fprintln(w, "203.0.113.1")
+		case "/json":
+			fmt.Fprint(w, `{"address":"203.0.113.2","country":"XX"}`)
+		case "/html":
+			fmt.Fprint(w, "<p>Your address: 203.0.113.3</p>")
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		name string
+		s    string
+		ip   string
+	}{
+		{"plain", "ipv4," + server.URL + "/plain,plain", "203.0.113.1"},
+		{"json", "ipv4," + server.URL + "/json,json,address", "203.0.113.2"},
+		{"regex submatch", "ipv4," + server.URL + "/html,regex,address: ([0-9.]+)", "203.0.113.3"},
+		{"regex match", "ipv4," + server.URL + `/html,regex,[0-9]+\.[0-9]+\.[0-9]+\.[0-9]+`, "203.0.113.3"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			config, err := ParseProviderConfig(test.s)
+			require.NoError(t, err)
+			getter, err := config.IPGetter()
+			require.NoError(t, err)
+			ip, err := getter.GetIP(getter.ServiceURL, context.Background())
+			require.NoError(t, err)
+			require.Equal(t, test.ip, ip)
+		})
+	}
+
+	config, err := ParseProviderConfig("ipv4," + server.URL + "/html,regex,Address: ([0-9.]+)")
+	require.NoError(t, err)
+	getter, err := config.IPGetter()
+	require.NoError(t, err)
+	_, err = getter.GetIP(getter.ServiceURL, context.Background())
+	require.Error(t, err)
+}
+
+func TestProviderConfigIPGetterErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+	}{
+		{"json without field", "ipv4,https://echo.example.com/json,json"},
+		{"invalid pattern", "ipv4,https://echo.example.com/html,regex,([0-9.]+"},
+		{"unknown format", "ipv4,https://echo.example.com/,xml"},
+		{"non-HTTP URL", "ipv4,ftp://echo.example.com/,plain"},
+		{"invalid STUN server", "ipv4,https://stun.example.com/,stun"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			config, err := ParseProviderConfig(test.s)
+			require.NoError(t, err)
+			_, err = config.IPGetter()
+			require.Error(t, err)
+		})
+	}
+}
+
+func serviceURLs(getters []IPGetter) []string {
+	urls := make([]string, len(getters))
+	for i, getter := range getters {
+		urls[i] = getter.ServiceURL
+	}
+	return urls
+}
+
+func TestParseProviders(t *testing.T) {
+	// families without configured providers fall back to the defaults
+	providers, err := ParseProviders(nil)
+	require.NoError(t, err)
+	require.Equal(t, serviceURLs(IPv4Getters), serviceURLs(providers[IPv4]))
+	require.Equal(t, serviceURLs(IPv6Getters), serviceURLs(providers[IPv6]))
+
+	providers, err = ParseProviders([]string{
+		"ipv4,https://a.example.com/,plain",
+		"",
+		"ipv4,stun:stun.example.com:3478,stun",
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"https://a.example.com/", "stun:stun.example.com:3478"}, serviceURLs(providers[IPv4]))
+	require.Equal(t, serviceURLs(IPv6Getters), serviceURLs(providers[IPv6]))
+
+	_, err = ParseProviders([]string{"ipv4,https://a.example.com/,plain", "ipv6,https://b.example.com/,xml"})
+	require.Error(t, err)
+}
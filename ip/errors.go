@@ -0,0 +1,112 @@
+package ip
+
+import (
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+)
+
+// HTTPStatusError is returned when a provider responds with a non-2xx status
+type HTTPStatusError struct {
+	URL        string
+	StatusCode int
+}
+
+func (e HTTPStatusError) Error() string {
+	return fmt.Sprintf("unexpected HTTP status %d", e.StatusCode)
+}
+
+// BodyTooLargeError is returned when the response of a provider exceeds the maximum size
+type BodyTooLargeError struct {
+	URL     string
+	MaxSize int
+}
+
+func (e BodyTooLargeError) Error() string {
+	return fmt.Sprintf("response exceeds %d bytes", e.MaxSize)
+}
+
+// InvalidIPError is returned when the answer of a provider is not an IP
+type InvalidIPError struct {
+	Value string
+}
+
+func (e InvalidIPError) Error() string {
+	value := e.Value
+	if len(value) > 64 {
+		value = value[:64] + "..."
+	}
+	return fmt.Sprintf("invalid IP %q", value)
+}
+
+// FamilyMismatchError is returned when the answer of a provider is not in the queried address family
+type FamilyMismatchError struct {
+	IP     net.IP
+	Family Family
+}
+
+func (e FamilyMismatchError) Error() string {
+	return fmt.Sprintf("IP %s is not an %s address", e.IP, e.Family)
+}
+
+// NonPublicIPError is returned when the answer of a provider is in a private, loopback or reserved range
+type NonPublicIPError struct {
+	IP    net.IP
+	Range string
+}
+
+func (e NonPublicIPError) Error() string {
+	return fmt.Sprintf("IP %s is not public (%s)", e.IP, e.Range)
+}
+
+// ProviderError is the reason why the answer of a provider is discarded
+type ProviderError struct {
+	ServiceURL string
+	Err        error
+}
+
+func (e ProviderError) Error() string {
+	return fmt.Sprintf("%s: %s", e.ServiceURL, e.Err)
+}
+
+// NoMajorityError is returned when not enough providers of a family agree on the same IP
+type NoMajorityError struct {
+	Family         Family
+	Quorum         int
+	Votes          map[string]int
+	ProviderErrors []ProviderError
+}
+
+func (e NoMajorityError) Error() string {
+	msg := fmt.Sprintf("cannot get %d providers agree on the same %s address", e.Quorum, e.Family)
+	var details []string
+	ips := make([]string, 0, len(e.Votes))
+	for ip := range e.Votes {
+		ips = append(ips, ip)
+	}
+	sort.Strings(ips)
+	for _, ip := range ips {
+		details = append(details, fmt.Sprintf("%s: %d votes", ip, e.Votes[ip]))
+	}
+	if len(e.ProviderErrors) > 0 {
+		details = append(details, fmt.Sprintf("%d providers failed", len(e.ProviderErrors)))
+	}
+	if len(details) == 0 {
+		return msg
+	}
+	return fmt.Sprintf("%s (%s)", msg, strings.Join(details, ", "))
+}
+
+// TooFewRespondersError is returned when fewer providers of a family than required answer a valid IP
+type TooFewRespondersError struct {
+	Family         Family
+	Responders     int
+	MinResponders  int
+	ProviderErrors []ProviderError
+}
+
+func (e TooFewRespondersError) Error() string {
+	return fmt.Sprintf("only %d providers answered a valid %s address, at least %d required (%d providers failed)",
+		e.Responders, e.Family, e.MinResponders, len(e.ProviderErrors))
+}
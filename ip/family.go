@@ -0,0 +1,98 @@
+package ip
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+)
+
+// Family is the address family of the discovered IP
+type Family string
+
+const (
+	IPv4 Family = "ipv4"
+	IPv6 Family = "ipv6"
+)
+
+// Families are the address families discovered in order of preference
+var Families = []Family{IPv4, IPv6}
+
+func ParseFamily(s string) (Family, error) {
+	switch Family(s) {
+	case IPv4, IPv6:
+		return Family(s), nil
+	default:
+		return "", fmt.Errorf("unknown IP address family: %s", s)
+	}
+}
+
+// Network returns the network used for dialing the providers, which forces dual-stack providers to see the address
+// of the family
+func (f Family) Network() string {
+	if f == IPv6 {
+		return "tcp6"
+	}
+	return "tcp4"
+}
+
+// PacketNetwork returns the network used for UDP providers such as STUN servers
+func (f Family) PacketNetwork() string {
+	if f == IPv6 {
+		return "udp6"
+	}
+	return "udp4"
+}
+
+// Match returns true if the IP is in the family
+func (f Family) Match(ip net.IP) bool {
+	if f == IPv6 {
+		return ip.To4() == nil && ip.To16() != nil
+	}
+	return ip.To4() != nil
+}
+
+type familyContextKey struct{}
+
+// WithFamily returns a context making the HTTP getters dial with the network of the family
+func WithFamily(ctx context.Context, family Family) context.Context {
+	return context.WithValue(ctx, familyContextKey{}, family)
+}
+
+func familyFromContext(ctx context.Context) (Family, bool) {
+	family, ok := ctx.Value(familyContextKey{}).(Family)
+	return family, ok
+}
+
+// newHTTPClient returns a client dialing the providers directly with the network, since the providers would see the
+// address of a proxy instead of the address of the node
+func newHTTPClient(network string) *http.Client {
+	dialer := &net.Dialer{Timeout: DefaultTimeout}
+	return &http.Client{
+		Transport: &http.Transport{
+			Proxy: nil,
+			DialContext: func(ctx context.Context, _, addr string) (net.Conn, error) {
+				return dialer.DialContext(ctx, network, addr)
+			},
+			TLSHandshakeTimeout: DefaultTimeout,
+		},
+	}
+}
+
+var httpClients = map[Family]*http.Client{
+	IPv4: newHTTPClient(IPv4.Network()),
+	IPv6: newHTTPClient(IPv6.Network()),
+}
+
+func httpClientFromContext(ctx context.Context) *http.Client {
+	family, ok := familyFromContext(ctx)
+	if !ok {
+		return http.DefaultClient
+	}
+	return httpClients[family]
+}
+
+// P2PAddress formats the IP and port as a Tendermint p2p address, with IPv6 addresses in brackets
+func P2PAddress(ip string, port string) string {
+	return fmt.Sprintf("tcp://%s", net.JoinHostPort(ip, port))
+}
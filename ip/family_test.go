@@ -0,0 +1,46 @@
+package ip
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFamilyMatch(t *testing.T) {
+	require.True(t, IPv4.Match(net.ParseIP("8.8.8.8")))
+	require.False(t, IPv4.Match(net.ParseIP("2606:4700::1111")))
+	require.True(t, IPv6.Match(net.ParseIP("2606:4700::1111")))
+	require.False(t, IPv6.Match(net.ParseIP("8.8.8.8")))
+	// IPv4-mapped IPv6 addresses are IPv4 addresses
+	require.False(t, IPv6.Match(net.ParseIP("::ffff:8.8.8.8")))
+}
+
+func TestHTTPClientFamily(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintln(w, "8.8.8.8")
+	}))
+	defer server.Close()
+
+	// the server only listens on IPv4, so it can only be reached by the IPv4 client
+	s, err := HTTPGetString(server.URL, WithFamily(context.Background(), IPv4))
+	require.NoError(t, err)
+	require.Equal(t, "8.8.8.8", s)
+	_, err = HTTPGetString(server.URL, WithFamily(context.Background(), IPv6))
+	require.Error(t, err)
+}
+
+func TestHTTPClientWithoutProxy(t *testing.T) {
+	for _, family := range Families {
+		require.Nil(t, httpClients[family].Transport.(*http.Transport).Proxy, "%s", family)
+	}
+}
+
+func TestP2PAddress(t *testing.T) {
+	require.Equal(t, "tcp://8.8.8.8:26656", P2PAddress("8.8.8.8", "26656"))
+	require.Equal(t, "tcp://[2001:db8::1]:26656", P2PAddress("2001:db8::1", "26656"))
+}
@@ -3,14 +3,19 @@ package ip
 import (
 	"context"
 	"encoding/json"
-	"errors"
+	"io"
 	"io/ioutil"
+	"net"
 	"net/http"
+	"strings"
 	"time"
 )
 
 const DefaultTimeout = 10 * time.Second
 
+// MaxBodySize is the maximum size of the response of an HTTP provider, which only needs to contain an IP
+const MaxBodySize = 4096
+
 type IPGetter struct {
 	ServiceURL string
 	GetIP      func(url string, ctx context.Context) (string, error)
@@ -21,15 +26,21 @@ func HTTPGetBody(url string, ctx context.Context) ([]byte, error) {
 	if err != nil {
 		return nil, err
 	}
-	res, err := http.DefaultClient.Do(req.WithContext(ctx))
+	res, err := httpClientFromContext(ctx).Do(req.WithContext(ctx))
 	if err != nil {
 		return nil, err
 	}
 	defer res.Body.Close()
-	bz, err := ioutil.ReadAll(res.Body)
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return nil, HTTPStatusError{URL: url, StatusCode: res.StatusCode}
+	}
+	bz, err := ioutil.ReadAll(io.LimitReader(res.Body, MaxBodySize+1))
 	if err != nil {
 		return nil, err
 	}
+	if len(bz) > MaxBodySize {
+		return nil, BodyTooLargeError{URL: url, MaxSize: MaxBodySize}
+	}
 	return bz, nil
 }
 
@@ -38,7 +49,7 @@ func HTTPGetString(url string, ctx context.Context) (string, error) {
 	if err != nil {
 		return "", err
 	}
-	return string(bz), err
+	return strings.TrimSpace(string(bz)), err
 }
 
 func HTTPJSONGetField(field string) func(string, context.Context) (string, error) {
@@ -56,29 +67,135 @@ func HTTPJSONGetField(field string) func(string, context.Context) (string, error
 	}
 }
 
-func RunProviders(ipGetters []IPGetter, timeout time.Duration) (string, error) {
-	ch := make(chan string, len(ipGetters))
-	for _, ipGetter := range ipGetters {
-		url := ipGetter.ServiceURL
-		go func(url string, ipGetter IPGetter) {
-			ctx, cancel := context.WithTimeout(context.Background(), timeout)
-			defer cancel()
-			ip, err := ipGetter.GetIP(url, ctx)
+// RunProviders queries the providers over the network of the family, and returns the IP agreed by the majority of them.
+// Answers which are not public IPs in the family are discarded, and the reasons are returned in NoMajorityError.
+func RunProviders(family Family, ipGetters []IPGetter, timeout time.Duration) (string, error) {
+	policy := DefaultPolicy()
+	policy.Timeout = timeout
+	report := RunProvidersWithPolicy(family, ipGetters, policy)
+	return report.IP, report.Err
+}
+
+// queryProvider queries a provider with retries, until it answers a valid IP or fails with an error which retrying
+// cannot fix
+func queryProvider(family Family, ipGetter IPGetter, policy Policy) ProviderResult {
+	res := ProviderResult{ServiceURL: ipGetter.ServiceURL}
+	backoff := policy.Backoff
+	for {
+		res.Attempts++
+		start := time.Now()
+		ctx, cancel := context.WithTimeout(WithFamily(context.Background(), family), policy.Timeout)
+		s, err := ipGetter.GetIP(ipGetter.ServiceURL, ctx)
+		cancel()
+		res.Latency = time.Since(start)
+		if err == nil {
+			var ip net.IP
+			ip, err = ValidateIP(family, s)
 			if err == nil {
-				ch <- ip
-			} else {
-				ch <- ""
+				res.IP = ip.String()
+				res.Err = nil
+				return res
 			}
-		}(url, ipGetter)
+		}
+		res.Err = err
+		if res.Attempts > policy.Retries || !retryable(err) {
+			return res
+		}
+		time.Sleep(backoff)
+		backoff *= 2
+	}
+}
+
+// agreedIP returns the IP voted by at least quorum providers, or the one with the most votes if a quorum less than
+// the majority lets several IPs reach it
+func agreedIP(votes map[string]int, quorum int) string {
+	agreed := ""
+	for ip, n := range votes {
+		if n >= quorum && (agreed == "" || n > votes[agreed] || (n == votes[agreed] && ip < agreed)) {
+			agreed = ip
+		}
+	}
+	return agreed
+}
+
+// RunProvidersWithPolicy queries the providers over the network of the family, and returns the report with the IP
+// agreed by the quorum of the policy, or the error with the reason of the failure
+func RunProvidersWithPolicy(family Family, ipGetters []IPGetter, policy Policy) *Report {
+	quorum, err := policy.QuorumOf(len(ipGetters))
+	if err != nil {
+		return &Report{Family: family, Err: err}
 	}
-	limit := len(ipGetters)/2 + 1
-	ipCount := map[string]int{}
+	report := &Report{Family: family, Quorum: quorum}
+	type answer struct {
+		index  int
+		result ProviderResult
+	}
+	ch := make(chan answer, len(ipGetters))
+	for i, ipGetter := range ipGetters {
+		go func(i int, ipGetter IPGetter) {
+			ch <- answer{i, queryProvider(family, ipGetter, policy)}
+		}(i, ipGetter)
+	}
+	results := make([]*ProviderResult, len(ipGetters))
+	votes := map[string]int{}
+	responders := 0
 	for i := 0; i < len(ipGetters); i++ {
-		ip := <-ch
-		ipCount[ip]++
-		if len(ip) > 0 && ipCount[ip] >= limit {
-			return ip, nil
+		ans := <-ch
+		results[ans.index] = &ans.result
+		if ans.result.Err != nil {
+			continue
+		}
+		responders++
+		votes[ans.result.IP]++
+		if !policy.WaitForAll && responders >= policy.MinResponders && agreedIP(votes, quorum) != "" {
+			break
+		}
+	}
+	if responders >= policy.MinResponders {
+		report.IP = agreedIP(votes, quorum)
+	}
+	var providerErrors []ProviderError
+	for _, res := range results {
+		if res == nil {
+			continue
 		}
+		report.Results = append(report.Results, *res)
+		if res.Err != nil {
+			providerErrors = append(providerErrors, ProviderError{ServiceURL: res.ServiceURL, Err: res.Err})
+		}
+	}
+	if report.IP != "" {
+		return report
+	}
+	if responders < policy.MinResponders {
+		report.Err = TooFewRespondersError{
+			Family:         family,
+			Responders:     responders,
+			MinResponders:  policy.MinResponders,
+			ProviderErrors: providerErrors,
+		}
+		return report
+	}
+	report.Err = NoMajorityError{Family: family, Quorum: quorum, Votes: votes, ProviderErrors: providerErrors}
+	return report
+}
+
+// DiscoverIPs runs the providers of each family concurrently, with a separate vote for each family
+func DiscoverIPs(providers Providers, families []Family, policy Policy) map[Family]*Report {
+	type result struct {
+		family Family
+		report *Report
+	}
+	ch := make(chan result, len(families))
+	for _, family := range families {
+		go func(family Family) {
+			ch <- result{family, RunProvidersWithPolicy(family, providers[family], policy)}
+		}(family)
+	}
+	reports := map[Family]*Report{}
+	for range families {
+		res := <-ch
+		reports[res.family] = res.report
 	}
-	return "", errors.New("cannot get majority agree on the same IP")
+	return reports
 }
@@ -0,0 +1,197 @@
+package ip
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// fixedGetter returns a getter answering the IP, or failing if the IP is empty, after the delay
+func fixedGetter(url string, ip string, delay time.Duration) IPGetter {
+	return IPGetter{
+		ServiceURL: url,
+		GetIP: func(string, context.Context) (string, error) {
+			time.Sleep(delay)
+			if ip == "" {
+				return "", errors.New("provider failed")
+			}
+			return ip, nil
+		},
+	}
+}
+
+func TestPolicyQuorumOf(t *testing.T) {
+	policy := DefaultPolicy()
+	for n, quorum := range map[int]int{1: 1, 2: 2, 3: 2, 4: 3, 5: 3} {
+		q, err := policy.QuorumOf(n)
+		require.NoError(t, err)
+		require.Equal(t, quorum, q, "%d providers", n)
+	}
+
+	policy.Quorum = 2
+	q, err := policy.QuorumOf(5)
+	require.NoError(t, err)
+	require.Equal(t, 2, q)
+	_, err = policy.QuorumOf(1)
+	require.Error(t, err)
+}
+
+func TestRunProvidersWithPolicy(t *testing.T) {
+	getters := []IPGetter{
+		fixedGetter("a", "8.8.8.8", 0),
+		fixedGetter("b", "8.8.8.8", 0),
+		fixedGetter("c", "1.1.1.1", 200*time.Millisecond),
+		fixedGetter("d", "", 200*time.Millisecond),
+	}
+	policy := DefaultPolicy()
+	policy.Quorum = 2
+
+	// the slow providers are omitted once the quorum is reached
+	report := RunProvidersWithPolicy(IPv4, getters, policy)
+	require.NoError(t, report.Err)
+	require.Equal(t, "8.8.8.8", report.IP)
+	require.Len(t, report.Results, 2)
+
+	policy.WaitForAll = true
+	report = RunProvidersWithPolicy(IPv4, getters, policy)
+	require.NoError(t, report.Err)
+	require.Equal(t, "8.8.8.8", report.IP)
+	require.Len(t, report.Results, 4)
+	require.Equal(t, "1.1.1.1", report.Results[2].IP)
+	require.Error(t, report.Results[3].Err)
+	require.Equal(t, map[string]int{"8.8.8.8": 2, "1.1.1.1": 1}, report.Votes())
+}
+
+func TestRunProvidersWithPolicyErrors(t *testing.T) {
+	getters := []IPGetter{
+		fixedGetter("a", "8.8.8.8", 0),
+		fixedGetter("b", "1.1.1.1", 0),
+		fixedGetter("c", "", 0),
+	}
+	policy := DefaultPolicy()
+
+	report := RunProvidersWithPolicy(IPv4, getters, policy)
+	require.IsType(t, NoMajorityError{}, report.Err)
+	require.Empty(t, report.IP)
+	require.Len(t, report.Results, 3)
+
+	policy.MinResponders = 3
+	policy.Quorum = 1
+	report = RunProvidersWithPolicy(IPv4, getters, policy)
+	require.IsType(t, TooFewRespondersError{}, report.Err)
+	require.Empty(t, report.IP)
+
+	// the quorum cannot be reached without querying the providers
+	policy = DefaultPolicy()
+	policy.Quorum = 4
+	report = RunProvidersWithPolicy(IPv4, getters, policy)
+	require.Error(t, report.Err)
+	require.Empty(t, report.Results)
+}
+
+func TestDiscoverIPs(t *testing.T) {
+	providers := Providers{
+		IPv4: []IPGetter{fixedGetter("a", "8.8.8.8", 0)},
+		IPv6: []IPGetter{fixedGetter("b", "2606:4700::1111", 0)},
+	}
+
+	reports := DiscoverIPs(providers, Families, DefaultPolicy())
+	require.Len(t, reports, 2)
+	require.Equal(t, "8.8.8.8", reports[IPv4].IP)
+	require.Equal(t, "2606:4700::1111", reports[IPv6].IP)
+
+	// only the providers of the requested families are queried
+	reports = DiscoverIPs(providers, []Family{IPv6}, DefaultPolicy())
+	require.Len(t, reports, 1)
+	require.Equal(t, "2606:4700::1111", reports[IPv6].IP)
+
+	// an answer in the other family is rejected
+	providers[IPv6] = []IPGetter{fixedGetter("c", "8.8.8.8", 0)}
+	reports = DiscoverIPs(providers, []Family{IPv6}, DefaultPolicy())
+	require.Error(t, reports[IPv6].Err)
+	require.IsType(t, FamilyMismatchError{}, reports[IPv6].Results[0].Err)
+}
+
+func TestValidateIP(t *testing.T) {
+	for _, tc := range []struct {
+		family Family
+		ip     string
+		err    error
+	}{
+		{IPv4, "8.8.8.8", nil},
+		{IPv4, " 1.1.1.1\n", nil},
+		{IPv6, "2606:4700::1111", nil},
+		{IPv4, "", InvalidIPError{}},
+		{IPv4, "<html>", InvalidIPError{}},
+		{IPv4, "2606:4700::1111", FamilyMismatchError{}},
+		{IPv6, "8.8.8.8", FamilyMismatchError{}},
+		{IPv6, "::ffff:8.8.8.8", FamilyMismatchError{}},
+		{IPv4, "0.1.2.3", NonPublicIPError{}},
+		{IPv4, "10.1.2.3", NonPublicIPError{}},
+		{IPv4, "100.64.1.2", NonPublicIPError{}},
+		{IPv4, "127.0.0.1", NonPublicIPError{}},
+		{IPv4, "169.254.1.2", NonPublicIPError{}},
+		{IPv4, "172.16.1.2", NonPublicIPError{}},
+		{IPv4, "172.31.1.2", NonPublicIPError{}},
+		{IPv4, "192.0.0.1", NonPublicIPError{}},
+		{IPv4, "192.0.2.1", NonPublicIPError{}},
+		{IPv4, "192.168.1.2", NonPublicIPError{}},
+		{IPv4, "198.18.1.2", NonPublicIPError{}},
+		{IPv4, "198.51.100.1", NonPublicIPError{}},
+		{IPv4, "203.0.113.1", NonPublicIPError{}},
+		{IPv4, "224.0.0.1", NonPublicIPError{}},
+		{IPv4, "255.255.255.255", NonPublicIPError{}},
+		{IPv6, "::", NonPublicIPError{}},
+		{IPv6, "::1", NonPublicIPError{}},
+		{IPv6, "64:ff9b:1::1", NonPublicIPError{}},
+		{IPv6, "100::1", NonPublicIPError{}},
+		{IPv6, "2001:db8::1", NonPublicIPError{}},
+		{IPv6, "fd00::1", NonPublicIPError{}},
+		{IPv6, "fe80::1", NonPublicIPError{}},
+		{IPv6, "ff02::1", NonPublicIPError{}},
+	} {
+		ip, err := ValidateIP(tc.family, tc.ip)
+		if tc.err == nil {
+			require.NoError(t, err, "%s %q", tc.family, tc.ip)
+			require.Equal(t, strings.TrimSpace(tc.ip), ip.String())
+		} else {
+			require.IsType(t, tc.err, err, "%s %q", tc.family, tc.ip)
+		}
+	}
+}
+
+func TestHTTPGetBody(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/error":
+			w.WriteHeader(http.StatusInternalServerError)
+			fmt.Fprint(w, "8.8.8.8")
+		case "/max":
+			fmt.Fprint(w, strings.Repeat(" ", MaxBodySize-len("8.8.8.8"))+"8.8.8.8")
+		case "/large":
+			fmt.Fprint(w, strings.Repeat(" ", MaxBodySize)+"8.8.8.8")
+		default:
+			fmt.Fprint(w, "8.8.8.8")
+		}
+	}))
+	defer server.Close()
+
+	bz, err := HTTPGetBody(server.URL+"/", context.Background())
+	require.NoError(t, err)
+	require.Equal(t, "8.8.8.8", string(bz))
+	bz, err = HTTPGetBody(server.URL+"/max", context.Background())
+	require.NoError(t, err)
+	require.Len(t, bz, MaxBodySize)
+
+	_, err = HTTPGetBody(server.URL+"/error", context.Background())
+	require.Equal(t, HTTPStatusError{URL: server.URL + "/error", StatusCode: http.StatusInternalServerError}, err)
+	_, err = HTTPGetBody(server.URL+"/large", context.Background())
+	require.IsType(t, BodyTooLargeError{}, err)
+}
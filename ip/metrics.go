@@ -0,0 +1,66 @@
+package ip
+
+import (
+	"github.com/go-kit/kit/metrics"
+	"github.com/go-kit/kit/metrics/discard"
+	"github.com/go-kit/kit/metrics/prometheus"
+	stdprometheus "github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	// MetricsSubsystem is a subsystem shared by all metrics exposed by this
+	// package.
+	MetricsSubsystem = "external_ip"
+)
+
+// Metrics contains metrics exposed by the external IP watcher.
+type Metrics struct {
+	// The detected external IP, 1 for the current IP of each family and 0 for the previous ones.
+	IP metrics.Gauge
+	// Number of times the external IP changed.
+	Changes metrics.Counter
+	// Number of failed detections.
+	Failures metrics.Counter
+	// Unix time of the last successful detection.
+	LastDetectionTime metrics.Gauge
+}
+
+// PrometheusMetrics returns Metrics build using Prometheus client library.
+func PrometheusMetrics(namespace string) *Metrics {
+	return &Metrics{
+		IP: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "info",
+			Help:      "The detected external IP, 1 for the current IP of each family.",
+		}, []string{"family", "ip"}),
+		Changes: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "changes",
+			Help:      "Number of times the external IP changed.",
+		}, []string{"family"}),
+		Failures: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "failures",
+			Help:      "Number of failed external IP detections.",
+		}, []string{"family"}),
+		LastDetectionTime: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "last_detection_time",
+			Help:      "Unix time of the last successful external IP detection.",
+		}, []string{"family"}),
+	}
+}
+
+// NopMetrics returns no-op Metrics.
+func NopMetrics() *Metrics {
+	return &Metrics{
+		IP:                discard.NewGauge(),
+		Changes:           discard.NewCounter(),
+		Failures:          discard.NewCounter(),
+		LastDetectionTime: discard.NewGauge(),
+	}
+}
@@ -0,0 +1,76 @@
+package ip
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Policy is how the answers of the providers are collected and voted
+type Policy struct {
+	// number of providers which must agree on the same IP, 0 for the majority of the providers
+	Quorum int
+	// minimum number of providers which must answer a valid IP, otherwise the result is unreliable
+	MinResponders int
+	// number of retries of a provider which failed to answer
+	Retries int
+	// delay before the first retry, doubled on each retry
+	Backoff time.Duration
+	// timeout of each attempt
+	Timeout time.Duration
+	// whether to wait for all providers to answer or fail before voting, instead of returning once the quorum is
+	// reached, so that the report includes every provider
+	WaitForAll bool
+}
+
+// DefaultPolicy returns the majority vote without retries
+func DefaultPolicy() Policy {
+	return Policy{
+		Quorum:        0,
+		MinResponders: 1,
+		Retries:       0,
+		Backoff:       time.Second,
+		Timeout:       DefaultTimeout,
+	}
+}
+
+func (p Policy) Validate() error {
+	if p.Quorum < 0 {
+		return errors.New("quorum cannot be negative")
+	}
+	if p.MinResponders < 0 {
+		return errors.New("minimum responders cannot be negative")
+	}
+	if p.Retries < 0 {
+		return errors.New("retries cannot be negative")
+	}
+	if p.Backoff < 0 {
+		return errors.New("backoff cannot be negative")
+	}
+	if p.Timeout <= 0 {
+		return errors.New("timeout must be positive")
+	}
+	return nil
+}
+
+// QuorumOf returns the number of agreeing providers needed out of n providers, and an error if the quorum can never be
+// reached by n providers
+func (p Policy) QuorumOf(n int) (int, error) {
+	if p.Quorum > n {
+		return 0, fmt.Errorf("quorum %d is more than the %d providers", p.Quorum, n)
+	}
+	if p.Quorum > 0 {
+		return p.Quorum, nil
+	}
+	return n/2 + 1, nil
+}
+
+// retryable returns false for the errors which cannot be fixed by asking the provider again
+func retryable(err error) bool {
+	switch err.(type) {
+	case InvalidIPError, FamilyMismatchError, NonPublicIPError:
+		return false
+	default:
+		return true
+	}
+}
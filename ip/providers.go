@@ -5,7 +5,9 @@ import (
 	"strings"
 )
 
-var IPGetters = []IPGetter{
+// IPv4Getters are the providers queried over IPv4, dual-stack providers and STUN servers are forced to IPv4 by the
+// dialer
+var IPv4Getters = []IPGetter{
 	{
 		ServiceURL: "https://canihazip.com/s",
 		GetIP:      HTTPGetString,
@@ -21,19 +23,47 @@ var IPGetters = []IPGetter{
 		},
 	},
 	{
-		ServiceURL: "https://api.myip.com",
+		ServiceURL: "https://ip4.seeip.org/json",
 		GetIP:      HTTPJSONGetField("ip"),
 	},
 	{
-		ServiceURL: "https://ip4.seeip.org/json",
+		ServiceURL: "https://api.ipify.org?format=json",
 		GetIP:      HTTPJSONGetField("ip"),
 	},
 	{
-		ServiceURL: "https://ipv4bot.whatismyipaddress.com/",
+		ServiceURL: "stun:stun.l.google.com:19302",
+		GetIP:      STUNGetIP,
+	},
+	{
+		ServiceURL: "stun:stun.cloudflare.com:3478",
+		GetIP:      STUNGetIP,
+	},
+}
+
+// IPv6Getters are the providers queried over IPv6
+var IPv6Getters = []IPGetter{
+	{
+		ServiceURL: "https://ipv6.icanhazip.com/",
 		GetIP:      HTTPGetString,
 	},
 	{
-		ServiceURL: "https://api.ipify.org?format=json",
+		ServiceURL: "https://v6.ident.me/",
+		GetIP:      HTTPGetString,
+	},
+	{
+		ServiceURL: "https://ip6.seeip.org/json",
+		GetIP:      HTTPJSONGetField("ip"),
+	},
+	{
+		ServiceURL: "https://api6.ipify.org?format=json",
 		GetIP:      HTTPJSONGetField("ip"),
 	},
+	{
+		ServiceURL: "stun:stun.l.google.com:19302",
+		GetIP:      STUNGetIP,
+	},
+	{
+		ServiceURL: "stun:stun.cloudflare.com:3478",
+		GetIP:      STUNGetIP,
+	},
 }
@@ -0,0 +1,95 @@
+package ip
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ProviderResult is the outcome of querying a provider
+type ProviderResult struct {
+	ServiceURL string
+	// the validated IP, empty if the provider failed
+	IP string
+	// latency of the last attempt
+	Latency  time.Duration
+	Attempts int
+	Err      error
+}
+
+func (r ProviderResult) String() string {
+	attempts := ""
+	if r.Attempts > 1 {
+		attempts = fmt.Sprintf(", %d attempts", r.Attempts)
+	}
+	if r.Err != nil {
+		return fmt.Sprintf("%s: %s (%s%s)", r.ServiceURL, r.Err, r.Latency.Round(time.Millisecond), attempts)
+	}
+	return fmt.Sprintf("%s: %s (%s%s)", r.ServiceURL, r.IP, r.Latency.Round(time.Millisecond), attempts)
+}
+
+func errorString(err error) string {
+	if err == nil {
+		return ""
+	}
+	return err.Error()
+}
+
+// MarshalJSON encodes the latency as a duration string and the error as its message
+func (r ProviderResult) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Provider string `json:"provider"`
+		IP       string `json:"ip,omitempty"`
+		Latency  string `json:"latency"`
+		Attempts int    `json:"attempts"`
+		Error    string `json:"error,omitempty"`
+	}{r.ServiceURL, r.IP, r.Latency.String(), r.Attempts, errorString(r.Err)})
+}
+
+// Report is the outcome of discovering the IP of a family. Once the quorum is reached, the providers which have not
+// answered yet are omitted from the results, unless the policy waits for all providers.
+type Report struct {
+	Family  Family
+	IP      string
+	Quorum  int
+	Results []ProviderResult
+	Err     error
+}
+
+// Votes returns the number of providers answering each IP
+func (r *Report) Votes() map[string]int {
+	votes := map[string]int{}
+	for _, res := range r.Results {
+		if res.Err == nil {
+			votes[res.IP]++
+		}
+	}
+	return votes
+}
+
+// String returns a summary line followed by an indented line for each provider
+func (r *Report) String() string {
+	lines := make([]string, 0, len(r.Results)+1)
+	if r.Err != nil {
+		lines = append(lines, fmt.Sprintf("%s: %s", r.Family, r.Err))
+	} else {
+		lines = append(lines, fmt.Sprintf("%s: %s (%d of %d providers agreed, quorum %d)",
+			r.Family, r.IP, r.Votes()[r.IP], len(r.Results), r.Quorum))
+	}
+	for _, res := range r.Results {
+		lines = append(lines, "  "+res.String())
+	}
+	return strings.Join(lines, "\n")
+}
+
+// MarshalJSON encodes the error as its message
+func (r *Report) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Family    Family           `json:"family"`
+		IP        string           `json:"ip,omitempty"`
+		Quorum    int              `json:"quorum"`
+		Error     string           `json:"error,omitempty"`
+		Providers []ProviderResult `json:"providers"`
+	}{r.Family, r.IP, r.Quorum, errorString(r.Err), r.Results})
+}
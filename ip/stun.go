@@ -0,0 +1,203 @@
+package ip
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+)
+
+// STUN (RFC 5389) binding request constants
+const (
+	stunBindingRequest         = 0x0001
+	stunBindingSuccessResponse = 0x0101
+	stunMagicCookie            = 0x2112A442
+	stunHeaderSize             = 20
+
+	stunAttrMappedAddress    = 0x0001
+	stunAttrXORMappedAddress = 0x0020
+
+	stunFamilyIPv4 = 0x01
+	stunFamilyIPv6 = 0x02
+
+	stunDefaultPort = "3478"
+	stunInitialRTO  = 500 * time.Millisecond
+	stunMaxPacket   = 1500
+)
+
+// STUNScheme is the URI scheme (RFC 7064) of STUN providers, e.g. "stun:stun.example.com:3478"
+const STUNScheme = "stun:"
+
+var errSTUNNoAddress = errors.New("STUN response has no mapped address")
+
+// stunServerAddr returns the host:port of a STUN URI
+func stunServerAddr(uri string) (string, error) {
+	if !strings.HasPrefix(uri, STUNScheme) {
+		return "", fmt.Errorf("invalid STUN URI %q", uri)
+	}
+	hostport := strings.TrimPrefix(uri, STUNScheme)
+	if _, _, err := net.SplitHostPort(hostport); err != nil {
+		// no port, or an IPv6 literal without port
+		hostport = net.JoinHostPort(strings.Trim(hostport, "[]"), stunDefaultPort)
+	}
+	return hostport, nil
+}
+
+func newSTUNBindingRequest() (req []byte, transactionID []byte, err error) {
+	req = make([]byte, stunHeaderSize)
+	binary.BigEndian.PutUint16(req[0:2], stunBindingRequest)
+	binary.BigEndian.PutUint16(req[2:4], 0)
+	binary.BigEndian.PutUint32(req[4:8], stunMagicCookie)
+	_, err = rand.Read(req[8:20])
+	if err != nil {
+		return nil, nil, err
+	}
+	return req, req[8:20], nil
+}
+
+// parseSTUNAddress parses a (XOR-)MAPPED-ADDRESS attribute value
+func parseSTUNAddress(value []byte, xor bool, transactionID []byte) (net.IP, error) {
+	if len(value) < 4 {
+		return nil, errors.New("STUN address attribute too short")
+	}
+	var ip net.IP
+	switch value[1] {
+	case stunFamilyIPv4:
+		if len(value) < 8 {
+			return nil, errors.New("STUN IPv4 address attribute too short")
+		}
+		ip = net.IP(append([]byte{}, value[4:8]...))
+	case stunFamilyIPv6:
+		if len(value) < 20 {
+			return nil, errors.New("STUN IPv6 address attribute too short")
+		}
+		ip = net.IP(append([]byte{}, value[4:20]...))
+	default:
+		return nil, fmt.Errorf("unknown STUN address family %d", value[1])
+	}
+	if xor {
+		key := make([]byte, 16)
+		binary.BigEndian.PutUint32(key[0:4], stunMagicCookie)
+		copy(key[4:16], transactionID)
+		for i := range ip {
+			ip[i] ^= key[i]
+		}
+	}
+	return ip, nil
+}
+
+// parseSTUNBindingResponse returns the mapped address in a binding success response, preferring XOR-MAPPED-ADDRESS
+func parseSTUNBindingResponse(res []byte, transactionID []byte) (net.IP, error) {
+	if len(res) < stunHeaderSize {
+		return nil, errors.New("STUN response too short")
+	}
+	if binary.BigEndian.Uint32(res[4:8]) != stunMagicCookie || !bytes.Equal(res[8:20], transactionID) {
+		return nil, errors.New("STUN response does not match the request")
+	}
+	if msgType := binary.BigEndian.Uint16(res[0:2]); msgType != stunBindingSuccessResponse {
+		return nil, fmt.Errorf("unexpected STUN message type 0x%04x", msgType)
+	}
+	length := int(binary.BigEndian.Uint16(res[2:4]))
+	if stunHeaderSize+length > len(res) {
+		return nil, errors.New("STUN response truncated")
+	}
+	attrs := res[stunHeaderSize : stunHeaderSize+length]
+	var mapped net.IP
+	for len(attrs) >= 4 {
+		attrType := binary.BigEndian.Uint16(attrs[0:2])
+		attrLen := int(binary.BigEndian.Uint16(attrs[2:4]))
+		if 4+attrLen > len(attrs) {
+			return nil, errors.New("STUN attribute truncated")
+		}
+		value := attrs[4 : 4+attrLen]
+		switch attrType {
+		case stunAttrXORMappedAddress:
+			return parseSTUNAddress(value, true, transactionID)
+		case stunAttrMappedAddress:
+			ip, err := parseSTUNAddress(value, false, transactionID)
+			if err != nil {
+				return nil, err
+			}
+			mapped = ip
+		}
+		// attributes are padded to 4 bytes
+		next := 4 + (attrLen+3)/4*4
+		if next > len(attrs) {
+			break
+		}
+		attrs = attrs[next:]
+	}
+	if mapped == nil {
+		return nil, errSTUNNoAddress
+	}
+	return mapped, nil
+}
+
+// STUNGetIP sends a STUN binding request to the STUN URI and returns the mapped address, retransmitting with doubling
+// timeout until the context is done
+func STUNGetIP(uri string, ctx context.Context) (string, error) {
+	addr, err := stunServerAddr(uri)
+	if err != nil {
+		return "", err
+	}
+	network := "udp"
+	if family, ok := familyFromContext(ctx); ok {
+		network = family.PacketNetwork()
+	}
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, network, addr)
+	if err != nil {
+		return "", err
+	}
+	defer conn.Close()
+
+	req, transactionID, err := newSTUNBindingRequest()
+	if err != nil {
+		return "", err
+	}
+	deadline, hasDeadline := ctx.Deadline()
+	if !hasDeadline {
+		deadline = time.Now().Add(DefaultTimeout)
+	}
+	buf := make([]byte, stunMaxPacket)
+	rto := stunInitialRTO
+	for {
+		if _, err := conn.Write(req); err != nil {
+			return "", err
+		}
+		readDeadline := time.Now().Add(rto)
+		if readDeadline.After(deadline) {
+			readDeadline = deadline
+		}
+		conn.SetReadDeadline(readDeadline)
+		for {
+			n, err := conn.Read(buf)
+			if err != nil {
+				if netErr, ok := err.(net.Error); ok && netErr.Timeout() {
+					break
+				}
+				return "", err
+			}
+			ip, err := parseSTUNBindingResponse(buf[:n], transactionID)
+			if err != nil {
+				// ignore stray packets, e.g. responses of retransmitted requests are identical
+				continue
+			}
+			return ip.String(), nil
+		}
+		if !time.Now().Before(deadline) {
+			return "", fmt.Errorf("no STUN response from %s", addr)
+		}
+		select {
+		case <-ctx.Done():
+			return "", ctx.Err()
+		default:
+		}
+		rto *= 2
+	}
+}
@@ -0,0 +1,194 @@
+package ip
+
+import (
+	"context"
+	"encoding/binary"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// stunAttr encodes an attribute with padding
+func stunAttr(attrType uint16, value []byte) []byte {
+	attr := make([]byte, 4+(len(value)+3)/4*4)
+	binary.BigEndian.PutUint16(attr[0:2], attrType)
+	binary.BigEndian.PutUint16(attr[2:4], uint16(len(value)))
+	copy(attr[4:], value)
+	return attr
+}
+
+// stunAddressAttr encodes a MAPPED-ADDRESS, or a XOR-MAPPED-ADDRESS if xor is set
+func stunAddressAttr(ip net.IP, port uint16, xor bool, transactionID []byte) []byte {
+	family := byte(stunFamilyIPv6)
+	addr := ip.To16()
+	if ip4 := ip.To4(); ip4 != nil {
+		family = stunFamilyIPv4
+		addr = ip4
+	}
+	value := make([]byte, 4+len(addr))
+	value[1] = family
+	binary.BigEndian.PutUint16(value[2:4], port)
+	copy(value[4:], addr)
+	attrType := uint16(stunAttrMappedAddress)
+	if xor {
+		attrType = stunAttrXORMappedAddress
+		key := make([]byte, 16)
+		binary.BigEndian.PutUint32(key[0:4], stunMagicCookie)
+		copy(key[4:16], transactionID)
+		binary.BigEndian.PutUint16(value[2:4], port^uint16(stunMagicCookie>>16))
+		for i := range addr {
+			value[4+i] ^= key[i]
+		}
+	}
+	return stunAttr(attrType, value)
+}
+
+// stunResponse encodes a binding success response to the transaction
+func stunResponse(transactionID []byte, attrs ...[]byte) []byte {
+	res := make([]byte, stunHeaderSize)
+	binary.BigEndian.PutUint16(res[0:2], stunBindingSuccessResponse)
+	binary.BigEndian.PutUint32(res[4:8], stunMagicCookie)
+	copy(res[8:20], transactionID)
+	for _, attr := range attrs {
+		res = append(res, attr...)
+	}
+	binary.BigEndian.PutUint16(res[2:4], uint16(len(res)-stunHeaderSize))
+	return res
+}
+
+// startSTUNServer starts a STUN responder on 127.0.0.1, which replies to the n-th request (counting from 0) with the
+// packets returned by respond, and returns the STUN URI of the responder and the function stopping it
+func startSTUNServer(t *testing.T, respond func(n int, transactionID []byte) [][]byte) (string, func()) {
+	conn, err := net.ListenPacket("udp4", "127.0.0.1:0")
+	require.NoError(t, err)
+	go func() {
+		buf := make([]byte, stunMaxPacket)
+		for n := 0; ; n++ {
+			size, addr, err := conn.ReadFrom(buf)
+			if err != nil {
+				return
+			}
+			if size < stunHeaderSize || binary.BigEndian.Uint16(buf[0:2]) != stunBindingRequest {
+				continue
+			}
+			transactionID := append([]byte{}, buf[8:20]...)
+			for _, packet := range respond(n, transactionID) {
+				conn.WriteTo(packet, addr)
+			}
+		}
+	}()
+	return STUNScheme + conn.LocalAddr().String(), func() { conn.Close() }
+}
+
+func stunGetIP(uri string, timeout time.Duration) (string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	return STUNGetIP(uri, ctx)
+}
+
+func TestSTUNGetIPXORMappedAddress(t *testing.T) {
+	uri, stop := startSTUNServer(t, func(n int, transactionID []byte) [][]byte {
+		// XOR-MAPPED-ADDRESS is preferred over MAPPED-ADDRESS, which may be rewritten by NATs
+		return [][]byte{stunResponse(transactionID,
+			stunAddressAttr(net.ParseIP("198.51.100.1"), 26656, false, transactionID),
+			stunAttr(0x8022, []byte("test")), // SOFTWARE
+			stunAddressAttr(net.ParseIP("203.0.113.1"), 26656, true, transactionID),
+		)}
+	})
+	defer stop()
+	ip, err := stunGetIP(uri, time.Second)
+	require.NoError(t, err)
+	require.Equal(t, "203.0.113.1", ip)
+}
+
+func TestSTUNGetIPMappedAddress(t *testing.T) {
+	uri, stop := startSTUNServer(t, func(n int, transactionID []byte) [][]byte {
+		return [][]byte{stunResponse(transactionID,
+			stunAttr(0x8022, []byte("legacy")), // SOFTWARE, padded to 4 bytes
+			stunAddressAttr(net.ParseIP("203.0.113.2"), 26656, false, transactionID),
+		)}
+	})
+	defer stop()
+	ip, err := stunGetIP(uri, time.Second)
+	require.NoError(t, err)
+	require.Equal(t, "203.0.113.2", ip)
+}
+
+func TestSTUNGetIPIgnoresStrayPackets(t *testing.T) {
+	uri, stop := startSTUNServer(t, func(n int, transactionID []byte) [][]byte {
+		otherTransactionID := append([]byte{}, transactionID...)
+		otherTransactionID[0]++
+		return [][]byte{
+			[]byte("not a STUN packet"),
+			stunResponse(otherTransactionID, stunAddressAttr(net.ParseIP("198.51.100.1"), 26656, true, otherTransactionID)),
+			stunResponse(transactionID, stunAttr(0x8022, []byte("no address"))),
+			stunResponse(transactionID, stunAddressAttr(net.ParseIP("203.0.113.3"), 26656, true, transactionID)),
+		}
+	})
+	defer stop()
+	ip, err := stunGetIP(uri, time.Second)
+	require.NoError(t, err)
+	require.Equal(t, "203.0.113.3", ip)
+}
+
+func TestSTUNGetIPRetransmits(t *testing.T) {
+	requests := make(chan int, 10)
+	uri, stop := startSTUNServer(t, func(n int, transactionID []byte) [][]byte {
+		requests <- n
+		// the first request is dropped
+		if n == 0 {
+			return nil
+		}
+		return [][]byte{stunResponse(transactionID, stunAddressAttr(net.ParseIP("203.0.113.4"), 26656, true, transactionID))}
+	})
+	defer stop()
+	ip, err := stunGetIP(uri, 3*time.Second)
+	require.NoError(t, err)
+	require.Equal(t, "203.0.113.4", ip)
+	require.Len(t, requests, 2)
+}
+
+func TestSTUNGetIPTimeout(t *testing.T) {
+	uri, stop := startSTUNServer(t, func(n int, transactionID []byte) [][]byte {
+		return nil
+	})
+	defer stop()
+	start := time.Now()
+	_, err := stunGetIP(uri, 200*time.Millisecond)
+	require.Error(t, err)
+	require.True(t, time.Since(start) < time.Second)
+}
+
+func TestParseSTUNBindingResponseIPv6(t *testing.T) {
+	_, transactionID, err := newSTUNBindingRequest()
+	require.NoError(t, err)
+	res := stunResponse(transactionID, stunAddressAttr(net.ParseIP("2001:db8::1"), 26656, true, transactionID))
+	ip, err := parseSTUNBindingResponse(res, transactionID)
+	require.NoError(t, err)
+	require.Equal(t, "2001:db8::1", ip.String())
+
+	// truncated attributes are rejected
+	_, err = parseSTUNBindingResponse(res[:len(res)-4], transactionID)
+	require.Error(t, err)
+}
+
+func TestSTUNServerAddr(t *testing.T) {
+	tests := []struct {
+		uri  string
+		addr string
+	}{
+		{"stun:stun.example.com:19302", "stun.example.com:19302"},
+		{"stun:stun.example.com", "stun.example.com:3478"},
+		{"stun:[2001:db8::1]:3478", "[2001:db8::1]:3478"},
+		{"stun:2001:db8::1", "[2001:db8::1]:3478"},
+	}
+	for _, test := range tests {
+		addr, err := stunServerAddr(test.uri)
+		require.NoError(t, err)
+		require.Equal(t, test.addr, addr)
+	}
+	_, err := stunServerAddr("https://stun.example.com")
+	require.Error(t, err)
+}
@@ -0,0 +1,67 @@
+package ip
+
+import (
+	"net"
+	"strings"
+)
+
+type ipRange struct {
+	network *net.IPNet
+	name    string
+}
+
+func mustParseRanges(ranges map[string]string) []ipRange {
+	res := make([]ipRange, 0, len(ranges))
+	for cidr, name := range ranges {
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			panic(err)
+		}
+		res = append(res, ipRange{network, name})
+	}
+	return res
+}
+
+// nonPublicRanges are the special-purpose ranges which cannot be the external address of a node
+var nonPublicRanges = mustParseRanges(map[string]string{
+	"0.0.0.0/8":       "this network",
+	"10.0.0.0/8":      "private",
+	"100.64.0.0/10":   "shared address space",
+	"127.0.0.0/8":     "loopback",
+	"169.254.0.0/16":  "link-local",
+	"172.16.0.0/12":   "private",
+	"192.0.0.0/24":    "IETF protocol assignments",
+	"192.0.2.0/24":    "documentation",
+	"192.168.0.0/16":  "private",
+	"198.18.0.0/15":   "benchmarking",
+	"198.51.100.0/24": "documentation",
+	"203.0.113.0/24":  "documentation",
+	"224.0.0.0/4":     "multicast",
+	"240.0.0.0/4":     "reserved",
+	"::/128":          "unspecified",
+	"::1/128":         "loopback",
+	"64:ff9b:1::/48":  "local-use IPv4/IPv6 translation",
+	"100::/64":        "discard-only",
+	"2001:db8::/32":   "documentation",
+	"fc00::/7":        "unique local",
+	"fe80::/10":       "link-local",
+	"ff00::/8":        "multicast",
+})
+
+// ValidateIP parses the answer of a provider, and returns the IP if it is a public address in the family
+func ValidateIP(family Family, s string) (net.IP, error) {
+	s = strings.TrimSpace(s)
+	ip := net.ParseIP(s)
+	if ip == nil {
+		return nil, InvalidIPError{Value: s}
+	}
+	if !family.Match(ip) {
+		return nil, FamilyMismatchError{IP: ip, Family: family}
+	}
+	for _, r := range nonPublicRanges {
+		if r.network.Contains(ip) {
+			return nil, NonPublicIPError{IP: ip, Range: r.name}
+		}
+	}
+	return ip, nil
+}
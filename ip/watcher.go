@@ -0,0 +1,120 @@
+package ip
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+// Watcher re-detects the external IPs periodically, and calls the callback when the IP of a family changes
+type Watcher struct {
+	providers Providers
+	families  []Family
+	interval  time.Duration
+	policy    Policy
+	logger    log.Logger
+	metrics   *Metrics
+	onChange  func(family Family, oldIP, newIP string)
+
+	mtx sync.Mutex
+	ips map[Family]string
+
+	quit     chan struct{}
+	done     chan struct{}
+	stopOnce sync.Once
+}
+
+// NewWatcher returns a watcher of the families, onChange is called from the watcher goroutine and may be nil
+func NewWatcher(
+	providers Providers, families []Family, policy Policy, interval time.Duration, logger log.Logger,
+	metrics *Metrics, onChange func(family Family, oldIP, newIP string),
+) *Watcher {
+	return &Watcher{
+		providers: providers,
+		families:  families,
+		interval:  interval,
+		policy:    policy,
+		logger:    logger,
+		metrics:   metrics,
+		onChange:  onChange,
+		ips:       map[Family]string{},
+		quit:      make(chan struct{}),
+		done:      make(chan struct{}),
+	}
+}
+
+// SetIP sets the known IP of the family, e.g. the IP detected on startup
+func (w *Watcher) SetIP(family Family, ip string) {
+	w.mtx.Lock()
+	defer w.mtx.Unlock()
+	w.ips[family] = ip
+	w.metrics.IP.With("family", string(family), "ip", ip).Set(1)
+}
+
+// IP returns the last detected IP of the family
+func (w *Watcher) IP(family Family) string {
+	w.mtx.Lock()
+	defer w.mtx.Unlock()
+	return w.ips[family]
+}
+
+func (w *Watcher) Start() {
+	go w.loop()
+}
+
+func (w *Watcher) Stop() {
+	w.stopOnce.Do(func() {
+		close(w.quit)
+		<-w.done
+	})
+}
+
+func (w *Watcher) loop() {
+	defer close(w.done)
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-w.quit:
+			return
+		case <-ticker.C:
+			w.Check()
+		}
+	}
+}
+
+// Check detects the IPs once, a failed detection keeps the known IP
+func (w *Watcher) Check() {
+	reports := DiscoverIPs(w.providers, w.families, w.policy)
+	for _, family := range w.families {
+		report := reports[family]
+		for _, res := range report.Results {
+			w.logger.Debug("queried IP provider", "provider", res.ServiceURL, "ip", res.IP, "latency", res.Latency,
+				"attempts", res.Attempts, "err", res.Err)
+		}
+		if report.Err != nil {
+			w.metrics.Failures.With("family", string(family)).Add(1)
+			w.logger.Error(fmt.Sprintf("failed to detect external %s address", family), "err", report.Err)
+			continue
+		}
+		newIP := report.IP
+		w.metrics.LastDetectionTime.With("family", string(family)).Set(float64(time.Now().Unix()))
+		oldIP := w.IP(family)
+		if newIP == oldIP {
+			continue
+		}
+		w.SetIP(family, newIP)
+		if oldIP == "" {
+			w.logger.Info(fmt.Sprintf("detected external %s address", family), "ip", newIP)
+			continue
+		}
+		w.metrics.IP.With("family", string(family), "ip", oldIP).Set(0)
+		w.metrics.Changes.With("family", string(family)).Add(1)
+		w.logger.Info(fmt.Sprintf("external %s address changed", family), "old", oldIP, "new", newIP)
+		if w.onChange != nil {
+			w.onChange(family, oldIP, newIP)
+		}
+	}
+}
@@ -0,0 +1,99 @@
+package ip
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+// changingGetter is a provider whose answer can be changed by the test
+type changingGetter struct {
+	mtx sync.Mutex
+	ip  string
+}
+
+func (g *changingGetter) set(ip string) {
+	g.mtx.Lock()
+	defer g.mtx.Unlock()
+	g.ip = ip
+}
+
+func (g *changingGetter) getter() IPGetter {
+	return IPGetter{
+		ServiceURL: "changing",
+		GetIP: func(string, context.Context) (string, error) {
+			g.mtx.Lock()
+			defer g.mtx.Unlock()
+			return g.ip, nil
+		},
+	}
+}
+
+type ipChange struct {
+	family       Family
+	oldIP, newIP string
+}
+
+func newTestWatcher(g *changingGetter, interval time.Duration) (*Watcher, chan ipChange) {
+	changes := make(chan ipChange, 10)
+	watcher := NewWatcher(
+		Providers{IPv4: []IPGetter{g.getter()}}, []Family{IPv4}, DefaultPolicy(), interval, log.NewNopLogger(),
+		NopMetrics(), func(family Family, oldIP, newIP string) {
+			changes <- ipChange{family, oldIP, newIP}
+		},
+	)
+	return watcher, changes
+}
+
+func TestWatcherCheck(t *testing.T) {
+	g := &changingGetter{ip: "8.8.8.8"}
+	watcher, changes := newTestWatcher(g, time.Hour)
+
+	// the first detection is not a change
+	watcher.Check()
+	require.Equal(t, "8.8.8.8", watcher.IP(IPv4))
+	require.Empty(t, changes)
+
+	watcher.Check()
+	require.Empty(t, changes)
+
+	g.set("1.1.1.1")
+	watcher.Check()
+	require.Equal(t, "1.1.1.1", watcher.IP(IPv4))
+	require.Equal(t, ipChange{IPv4, "8.8.8.8", "1.1.1.1"}, <-changes)
+
+	// a failed detection keeps the known IP
+	g.set("")
+	watcher.Check()
+	require.Equal(t, "1.1.1.1", watcher.IP(IPv4))
+	require.Empty(t, changes)
+}
+
+func TestWatcherStartStop(t *testing.T) {
+	g := &changingGetter{ip: "8.8.8.8"}
+	watcher, changes := newTestWatcher(g, 10*time.Millisecond)
+	watcher.SetIP(IPv4, "8.8.8.8")
+	watcher.Start()
+
+	g.set("1.1.1.1")
+	select {
+	case change := <-changes:
+		require.Equal(t, ipChange{IPv4, "8.8.8.8", "1.1.1.1"}, change)
+	case <-time.After(5 * time.Second):
+		t.Fatal("the change is not detected")
+	}
+
+	watcher.Stop()
+	// no detection runs after Stop returns
+	g.set("8.8.4.4")
+	time.Sleep(50 * time.Millisecond)
+	require.Equal(t, "1.1.1.1", watcher.IP(IPv4))
+	require.Empty(t, changes)
+	// stopping again is a no-op
+	watcher.Stop()
+}
@@ -0,0 +1,48 @@
+package nat
+
+import (
+	"bufio"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"net"
+	"os"
+	"strings"
+)
+
+const procNetRoute = "/proc/net/route"
+
+// DefaultGateway returns the IPv4 default gateway from the routing table, which is only supported on Linux
+func DefaultGateway() (net.IP, error) {
+	f, err := os.Open(procNetRoute)
+	if err != nil {
+		return nil, errors.New("cannot read the routing table, please specify the gateway")
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	// skip the header
+	scanner.Scan()
+	for scanner.Scan() {
+		// Iface Destination Gateway Flags ...
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 3 || fields[1] != "00000000" {
+			continue
+		}
+		bz, err := hex.DecodeString(fields[2])
+		if err != nil || len(bz) != 4 {
+			continue
+		}
+		// the addresses in /proc/net/route are in host byte order, which is little-endian on supported platforms
+		ip := make(net.IP, 4)
+		binary.BigEndian.PutUint32(ip, binary.LittleEndian.Uint32(bz))
+		if ip.IsUnspecified() {
+			continue
+		}
+		return ip, nil
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return nil, errors.New("no default gateway found")
+}
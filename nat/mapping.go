@@ -0,0 +1,104 @@
+package nat
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+// MinLifetime is the minimum lifetime of port mappings, which are renewed every half of the lifetime in whole seconds
+const MinLifetime = 2 * time.Second
+
+// PortMapping is a TCP port mapping on the gateway, renewed before its lifetime expires until it is closed
+type PortMapping struct {
+	mapper       Mapper
+	internalPort int
+	lifetime     time.Duration
+	logger       log.Logger
+
+	mtx          sync.Mutex
+	externalPort int
+
+	quit      chan struct{}
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+// MapPort maps the internal port to the same external port if the gateway allows, and renews the mapping every half
+// of the lifetime
+func MapPort(mapper Mapper, internalPort int, lifetime time.Duration, logger log.Logger) (*PortMapping, error) {
+	if lifetime < MinLifetime {
+		return nil, errors.New("port mapping lifetime must be at least 2 seconds")
+	}
+	externalPort, err := mapper.AddPortMapping(internalPort, internalPort, lifetime)
+	if err != nil {
+		return nil, err
+	}
+	m := &PortMapping{
+		mapper:       mapper,
+		internalPort: internalPort,
+		externalPort: externalPort,
+		lifetime:     lifetime,
+		logger:       logger,
+		quit:         make(chan struct{}),
+		done:         make(chan struct{}),
+	}
+	logger.Info(fmt.Sprintf("mapped external port %d to internal port %d with %s", externalPort, internalPort, mapper.Protocol()))
+	go m.renewLoop()
+	return m, nil
+}
+
+func (m *PortMapping) ExternalPort() int {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	return m.externalPort
+}
+
+func (m *PortMapping) renewLoop() {
+	defer close(m.done)
+	ticker := time.NewTicker(m.lifetime / 2)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-m.quit:
+			return
+		case <-ticker.C:
+			oldExternalPort := m.ExternalPort()
+			externalPort, err := m.mapper.AddPortMapping(m.internalPort, oldExternalPort, m.lifetime)
+			if err != nil {
+				m.logger.Error("failed to renew port mapping", "err", err)
+				continue
+			}
+			if externalPort != oldExternalPort {
+				m.logger.Error(fmt.Sprintf("gateway changed the external port from %d to %d, p2p.external_address is outdated",
+					oldExternalPort, externalPort))
+				m.mtx.Lock()
+				m.externalPort = externalPort
+				m.mtx.Unlock()
+			}
+		}
+	}
+}
+
+// ExternalIP returns the external IP reported by the gateway
+func (m *PortMapping) ExternalIP() (net.IP, error) {
+	return m.mapper.ExternalIP()
+}
+
+// Close stops renewing and removes the mapping
+func (m *PortMapping) Close() (err error) {
+	m.closeOnce.Do(func() {
+		close(m.quit)
+		<-m.done
+		externalPort := m.ExternalPort()
+		err = m.mapper.DeletePortMapping(m.internalPort, externalPort)
+		if err == nil {
+			m.logger.Info(fmt.Sprintf("removed mapping of external port %d", externalPort))
+		}
+	})
+	return err
+}
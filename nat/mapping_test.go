@@ -0,0 +1,63 @@
+package nat
+
+import (
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+// fakeMapper grants the external ports in order, and records the deleted mappings
+type fakeMapper struct {
+	mtx           sync.Mutex
+	externalPorts []int
+	added         int
+	deleted       []int
+}
+
+func (m *fakeMapper) Protocol() string { return "fake" }
+
+func (m *fakeMapper) ExternalIP() (net.IP, error) { return net.ParseIP("203.0.113.1"), nil }
+
+func (m *fakeMapper) AddPortMapping(internalPort, externalPort int, lifetime time.Duration) (int, error) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	port := m.externalPorts[m.added]
+	if m.added < len(m.externalPorts)-1 {
+		m.added++
+	}
+	return port, nil
+}
+
+func (m *fakeMapper) DeletePortMapping(internalPort, externalPort int) error {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	m.deleted = append(m.deleted, externalPort)
+	return nil
+}
+
+func TestMapPortLifetime(t *testing.T) {
+	for _, lifetime := range []time.Duration{0, 1, time.Second} {
+		_, err := MapPort(&fakeMapper{externalPorts: []int{26656}}, 26656, lifetime, log.NewNopLogger())
+		require.Error(t, err, lifetime)
+	}
+}
+
+func TestPortMappingRenewAndClose(t *testing.T) {
+	mapper := &fakeMapper{externalPorts: []int{26656, 30000}}
+	mapping, err := MapPort(mapper, 26656, MinLifetime, log.NewNopLogger())
+	require.NoError(t, err)
+	require.Equal(t, 26656, mapping.ExternalPort())
+
+	// renewed after half of the lifetime, with the external port changed by the gateway
+	require.Eventually(t, func() bool {
+		return mapping.ExternalPort() == 30000
+	}, 3*time.Second, 50*time.Millisecond)
+
+	require.NoError(t, mapping.Close())
+	require.NoError(t, mapping.Close())
+	require.Equal(t, []int{30000}, mapper.deleted)
+}
@@ -0,0 +1,49 @@
+package nat
+
+import (
+	"fmt"
+	"net"
+	"time"
+)
+
+// supported port mapping protocols
+const (
+	ProtocolUPnP   = "upnp"
+	ProtocolNATPMP = "natpmp"
+	ProtocolAuto   = "auto"
+)
+
+// Mapper maps TCP ports on the gateway to the node
+type Mapper interface {
+	// Protocol returns the port mapping protocol
+	Protocol() string
+	// ExternalIP returns the external IP reported by the gateway
+	ExternalIP() (net.IP, error)
+	// AddPortMapping maps the external port to the internal port, and returns the external port granted by the gateway
+	AddPortMapping(internalPort, externalPort int, lifetime time.Duration) (int, error)
+	// DeletePortMapping removes the mapping of the external port
+	DeletePortMapping(internalPort, externalPort int) error
+}
+
+// Discover returns the mapper of the gateway using the protocol, auto tries NAT-PMP before UPnP. The gateway is only
+// used by NAT-PMP, and is the default gateway if nil.
+func Discover(protocol string, gateway net.IP) (Mapper, error) {
+	switch protocol {
+	case ProtocolUPnP:
+		return DiscoverUPnP()
+	case ProtocolNATPMP:
+		return DiscoverNATPMP(gateway)
+	case ProtocolAuto:
+		mapper, natpmpErr := DiscoverNATPMP(gateway)
+		if natpmpErr == nil {
+			return mapper, nil
+		}
+		mapper, upnpErr := DiscoverUPnP()
+		if upnpErr == nil {
+			return mapper, nil
+		}
+		return nil, fmt.Errorf("no NAT gateway found (NAT-PMP: %s; UPnP: %s)", natpmpErr, upnpErr)
+	default:
+		return nil, fmt.Errorf("unknown port mapping protocol: %s", protocol)
+	}
+}
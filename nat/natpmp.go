@@ -0,0 +1,127 @@
+package nat
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"net"
+	"time"
+)
+
+// NAT-PMP (RFC 6886) constants
+const (
+	natpmpPort           = 5351
+	natpmpVersion        = 0
+	natpmpOpExternalIP   = 0
+	natpmpOpMapTCP       = 2
+	natpmpResponseOffset = 128
+	natpmpInitialRTO     = 250 * time.Millisecond
+	natpmpMaxAttempts    = 4
+)
+
+var natpmpResultMessages = map[uint16]string{
+	1: "unsupported version",
+	2: "not authorized or refused",
+	3: "network failure",
+	4: "out of resources",
+	5: "unsupported opcode",
+}
+
+type natpmpMapper struct {
+	gateway net.IP
+}
+
+// DiscoverNATPMP returns the NAT-PMP mapper of the gateway if it responds to NAT-PMP, the default gateway is used if
+// the gateway is nil
+func DiscoverNATPMP(gateway net.IP) (Mapper, error) {
+	if gateway == nil {
+		var err error
+		gateway, err = DefaultGateway()
+		if err != nil {
+			return nil, err
+		}
+	}
+	m := natpmpMapper{gateway}
+	if _, err := m.ExternalIP(); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (m natpmpMapper) Protocol() string {
+	return ProtocolNATPMP
+}
+
+// request sends the request to the gateway, retransmitting with doubling timeout, and returns the response
+func (m natpmpMapper) request(req []byte, resSize int) ([]byte, error) {
+	conn, err := net.DialUDP("udp4", nil, &net.UDPAddr{IP: m.gateway, Port: natpmpPort})
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	buf := make([]byte, 16)
+	rto := natpmpInitialRTO
+	for i := 0; i < natpmpMaxAttempts; i++ {
+		if _, err := conn.Write(req); err != nil {
+			return nil, err
+		}
+		conn.SetReadDeadline(time.Now().Add(rto))
+		n, err := conn.Read(buf)
+		if err != nil {
+			if netErr, ok := err.(net.Error); ok && netErr.Timeout() {
+				rto *= 2
+				continue
+			}
+			return nil, err
+		}
+		res := buf[:n]
+		if n < resSize || res[0] != natpmpVersion || res[1] != req[1]+natpmpResponseOffset {
+			continue
+		}
+		if resultCode := binary.BigEndian.Uint16(res[2:4]); resultCode != 0 {
+			msg, ok := natpmpResultMessages[resultCode]
+			if !ok {
+				msg = fmt.Sprintf("result code %d", resultCode)
+			}
+			return nil, fmt.Errorf("NAT-PMP request failed: %s", msg)
+		}
+		return res, nil
+	}
+	return nil, fmt.Errorf("no NAT-PMP response from %s", m.gateway)
+}
+
+func (m natpmpMapper) ExternalIP() (net.IP, error) {
+	res, err := m.request([]byte{natpmpVersion, natpmpOpExternalIP}, 12)
+	if err != nil {
+		return nil, err
+	}
+	return net.IPv4(res[8], res[9], res[10], res[11]), nil
+}
+
+func (m natpmpMapper) mapTCP(internalPort, externalPort int, lifetime time.Duration) (int, error) {
+	req := make([]byte, 12)
+	req[0] = natpmpVersion
+	req[1] = natpmpOpMapTCP
+	binary.BigEndian.PutUint16(req[4:6], uint16(internalPort))
+	binary.BigEndian.PutUint16(req[6:8], uint16(externalPort))
+	binary.BigEndian.PutUint32(req[8:12], uint32(lifetime/time.Second))
+	res, err := m.request(req, 16)
+	if err != nil {
+		return 0, err
+	}
+	return int(binary.BigEndian.Uint16(res[10:12])), nil
+}
+
+func (m natpmpMapper) AddPortMapping(internalPort, externalPort int, lifetime time.Duration) (int, error) {
+	if lifetime < time.Second {
+		return 0, errors.New("NAT-PMP mapping lifetime must be at least 1 second")
+	}
+	return m.mapTCP(internalPort, externalPort, lifetime)
+}
+
+// DeletePortMapping removes the mapping, which is identified by the internal port in NAT-PMP
+func (m natpmpMapper) DeletePortMapping(internalPort, externalPort int) error {
+	_, err := m.mapTCP(internalPort, 0, 0)
+	return err
+}
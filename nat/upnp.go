@@ -0,0 +1,39 @@
+package nat
+
+import (
+	"net"
+	"time"
+
+	"github.com/tendermint/tendermint/p2p/upnp"
+)
+
+const upnpDescription = "liked p2p"
+
+type upnpMapper struct {
+	nat upnp.NAT
+}
+
+// DiscoverUPnP discovers the UPnP Internet Gateway Device in the local network
+func DiscoverUPnP() (Mapper, error) {
+	nat, err := upnp.Discover()
+	if err != nil {
+		return nil, err
+	}
+	return upnpMapper{nat}, nil
+}
+
+func (m upnpMapper) Protocol() string {
+	return ProtocolUPnP
+}
+
+func (m upnpMapper) ExternalIP() (net.IP, error) {
+	return m.nat.GetExternalAddress()
+}
+
+func (m upnpMapper) AddPortMapping(internalPort, externalPort int, lifetime time.Duration) (int, error) {
+	return m.nat.AddPortMapping("tcp", externalPort, internalPort, upnpDescription, int(lifetime/time.Second))
+}
+
+func (m upnpMapper) DeletePortMapping(internalPort, externalPort int) error {
+	return m.nat.DeletePortMapping("tcp", externalPort, internalPort)
+}
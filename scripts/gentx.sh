@@ -13,6 +13,10 @@ COMMISSION_RATE_CHANGE="0.01"
 
 read -p "Enter some description of your node: " DETAILS
 read -p "Enter the amount you want to stake (including the coin name, example: '1000000000nanolike'):" AMOUNT
+read -p "Enter the minimum self delegation in nanolike, which must not be lower than the one required in the genesis whitelist params, default='1':" MIN_SELF_DELEGATION
+if [ -z $MIN_SELF_DELEGATION ]; then
+    MIN_SELF_DELEGATION="1"
+fi
 read -p "(Optional) Enter identity of your node (e.g. UPort, Keybase): " IDENTITY
 read -p "(Optional) Enter the website of your site (optional): " WEBSITE
 
@@ -34,6 +38,7 @@ docker run -it --rm \
         --details "$DETAILS" \
         --website "$WEBSITE" \
         --amount "$AMOUNT" \
+        --min-self-delegation "$MIN_SELF_DELEGATION" \
         --commission-rate "$COMMISSION_RATE" \
         --commission-max-rate "$COMMISSION_RATE_MAX" \
         --commission-max-change-rate "$COMMISSION_RATE_CHANGE"
@@ -46,6 +46,12 @@ if [ -z $AMOUNT ]; then
 fi
 echo "Staking amount: $AMOUNT"
 
+MIN_SELF_DELEGATION=$(docker exec likechain_liked likecli --home /likechain/.likecli query whitelist params --trust-node --output json | grep '"min_self_delegation"' | sed 's/.*"min_self_delegation":"\([0-9]*\)".*/\1/g')
+if [ -z $MIN_SELF_DELEGATION ]; then
+    MIN_SELF_DELEGATION="1"
+fi
+echo "Minimum self delegation required by the chain: ${MIN_SELF_DELEGATION}nanolike"
+
 read -p "Enter some description of your node: " DETAILS
 
 read -p "(Optional) Enter identity of your node (e.g. UPort, Keybase): " IDENTITY
@@ -65,7 +71,7 @@ docker exec -it likechain_liked \
         --identity "$IDENTITY" \
         --details "$DETAILS" \
         --website "$WEBSITE" \
-        --min-self-delegation 1 \
+        --min-self-delegation "$MIN_SELF_DELEGATION" \
         --from validator \
         --chain-id "$CHAIN_ID" \
         --node tcp://liked:26657 \
@@ -0,0 +1,108 @@
+package gov
+
+import (
+	"github.com/likecoin/likechain/x/gov/types"
+)
+
+const (
+	ModuleName                   = types.ModuleName
+	StoreKey                     = types.StoreKey
+	RouterKey                    = types.RouterKey
+	QueryWrapperParams           = types.QueryWrapperParams
+	QueryAuthorizedVoter         = types.QueryAuthorizedVoter
+	QueryVoterValidator          = types.QueryVoterValidator
+	QueryEmergencyProposals      = types.QueryEmergencyProposals
+	QueryTallyResults            = types.QueryTallyResults
+	QueryProposerQuota           = types.QueryProposerQuota
+	QueryProposerQuotas          = types.QueryProposerQuotas
+	TallyModeStakeWeighted       = types.TallyModeStakeWeighted
+	TallyModeOneValidatorOneVote = types.TallyModeOneValidatorOneVote
+	ModeBondedValidators         = types.ModeBondedValidators
+	ModeValidators               = types.ModeValidators
+	ModeAnyAccount               = types.ModeAnyAccount
+	ModeAllowlist                = types.ModeAllowlist
+)
+
+var (
+	ModuleCdc                        = types.ModuleCdc
+	RegisterCodec                    = types.RegisterCodec
+	NewMsgAuthorizeVoter             = types.NewMsgAuthorizeVoter
+	NewMsgRevokeVoter                = types.NewMsgRevokeVoter
+	NewVoterAuthorization            = types.NewVoterAuthorization
+	KeyProposerMode                  = types.KeyProposerMode
+	KeyVoterMode                     = types.KeyVoterMode
+	KeyProposerAllowlist             = types.KeyProposerAllowlist
+	KeyVoterAllowlist                = types.KeyVoterAllowlist
+	KeyProposalTypeAllowlist         = types.KeyProposalTypeAllowlist
+	KeyProposalTypeDenylist          = types.KeyProposalTypeDenylist
+	KeyEmergencyVotingPeriod         = types.KeyEmergencyVotingPeriod
+	KeyEmergencyThreshold            = types.KeyEmergencyThreshold
+	KeyProposalTallyParams           = types.KeyProposalTallyParams
+	KeyTallyMode                     = types.KeyTallyMode
+	KeyValidatorPowerCap             = types.KeyValidatorPowerCap
+	KeyMaxActiveProposals            = types.KeyMaxActiveProposals
+	KeyMaxProposalsPerWindow         = types.KeyMaxProposalsPerWindow
+	KeyProposalRateWindow            = types.KeyProposalRateWindow
+	NewProposalType                  = types.NewProposalType
+	NewTallyResults                  = types.NewTallyResults
+	NewProposalTallyParams           = types.NewProposalTallyParams
+	VoterKey                         = types.VoterKey
+	VoterValidatorKey                = types.VoterValidatorKey
+	VoterKeyPrefix                   = types.VoterKeyPrefix
+	EmergencyProposalKey             = types.EmergencyProposalKey
+	EmergencyProposalKeyPrefix       = types.EmergencyProposalKeyPrefix
+	SplitEmergencyProposalKey        = types.SplitEmergencyProposalKey
+	ProposalSubmissionKey            = types.ProposalSubmissionKey
+	ProposalSubmissionKeyPrefix      = types.ProposalSubmissionKeyPrefix
+	ProposerSubmissionsPrefix        = types.ProposerSubmissionsPrefix
+	ProposalProposerKey              = types.ProposalProposerKey
+	ProposalProposerKeyPrefix        = types.ProposalProposerKeyPrefix
+	NewProposalSubmission            = types.NewProposalSubmission
+	NewQueryProposerQuotaParams      = types.NewQueryProposerQuotaParams
+	NewMsgSubmitEmergencyProposal    = types.NewMsgSubmitEmergencyProposal
+	DefaultParams                    = types.DefaultParams
+	DefaultGenesisState              = types.DefaultGenesisState
+	DefaultCodespace                 = types.DefaultCodespace
+	ValidateGenesis                  = types.ValidateGenesis
+	ErrProposerNotAllowed            = types.ErrProposerNotAllowed
+	ErrVoterNotAllowed               = types.ErrVoterNotAllowed
+	ErrInvalidValidator              = types.ErrInvalidValidator
+	ErrInvalidVoter                  = types.ErrInvalidVoter
+	ErrVoterAlreadyAuthorized        = types.ErrVoterAlreadyAuthorized
+	ErrVoterNotFound                 = types.ErrVoterNotFound
+	ErrProposalTypeNotAllowed        = types.ErrProposalTypeNotAllowed
+	ErrEmergencyProposerNotValidator = types.ErrEmergencyProposerNotValidator
+	ErrUnknownProposal               = types.ErrUnknownProposal
+	ErrProposalTallied               = types.ErrProposalTallied
+	ErrTooManyActiveProposals        = types.ErrTooManyActiveProposals
+	ErrTooManyProposalsInWindow      = types.ErrTooManyProposalsInWindow
+	EventTypeAuthorizeVoter          = types.EventTypeAuthorizeVoter
+	EventTypeRevokeVoter             = types.EventTypeRevokeVoter
+	EventTypeDelegatedVote           = types.EventTypeDelegatedVote
+	EventTypeEmergencyProposal       = types.EventTypeEmergencyProposal
+	AttributeKeyValidator            = types.AttributeKeyValidator
+	AttributeKeyVoter                = types.AttributeKeyVoter
+	AttributeKeyProposalID           = types.AttributeKeyProposalID
+	AttributeValueCategory           = types.AttributeValueCategory
+)
+
+type (
+	MsgAuthorizeVoter          = types.MsgAuthorizeVoter
+	MsgRevokeVoter             = types.MsgRevokeVoter
+	MsgSubmitEmergencyProposal = types.MsgSubmitEmergencyProposal
+	VoterAuthorization         = types.VoterAuthorization
+	QueryAuthorizedVoterParams = types.QueryAuthorizedVoterParams
+	QueryVoterValidatorParams  = types.QueryVoterValidatorParams
+	ParticipationMode          = types.ParticipationMode
+	ProposalType               = types.ProposalType
+	ProposalTallyParams        = types.ProposalTallyParams
+	TallyMode                  = types.TallyMode
+	TallyResults               = types.TallyResults
+	ProposalSubmission         = types.ProposalSubmission
+	ProposerQuota              = types.ProposerQuota
+	ProposerQuotas             = types.ProposerQuotas
+	QueryProposerQuotaParams   = types.QueryProposerQuotaParams
+	Params                     = types.Params
+	GenesisState               = types.GenesisState
+	StakingKeeper              = types.StakingKeeper
+)
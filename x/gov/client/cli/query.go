@@ -0,0 +1,241 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+	"github.com/likecoin/likechain/x/gov/types"
+)
+
+// GetQueryCmds returns the wrapper specific query commands, which are added to the gov query command
+func GetQueryCmds(queryRoute string, cdc *codec.Codec) []*cobra.Command {
+	return client.GetCommands(
+		GetCmdQueryWrapperParams(queryRoute, cdc),
+		GetCmdQueryAuthorizedVoter(queryRoute, cdc),
+		GetCmdQueryVoterValidator(queryRoute, cdc),
+		GetCmdQueryEmergencyProposals(queryRoute, cdc),
+		GetCmdQueryTallyResults(queryRoute, cdc),
+		GetCmdQueryProposerQuota(queryRoute, cdc),
+		GetCmdQueryProposerQuotas(queryRoute, cdc),
+	)
+}
+
+// GetCmdQueryWrapperParams implements the gov wrapper params query command.
+func GetCmdQueryWrapperParams(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "wrapper-params",
+		Short: "Query the parameters of the LikeChain gov wrapper",
+		Long: strings.TrimSpace(`Query the parameters of the LikeChain gov wrapper, which control who can submit proposals and vote:
+
+$ likecli query gov wrapper-params
+`),
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.Query(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryWrapperParams))
+			if err != nil {
+				return err
+			}
+
+			var params types.Params
+			cdc.MustUnmarshalJSON(res, &params)
+			return cliCtx.PrintOutput(params)
+		},
+	}
+}
+
+// GetCmdQueryAuthorizedVoter implements the query command for the voter authorized by a validator.
+func GetCmdQueryAuthorizedVoter(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "authorized-voter [validator-addr]",
+		Short: "Query the account authorized to vote on behalf of a validator",
+		Long: strings.TrimSpace(`Query the account authorized to vote on behalf of a validator:
+
+$ likecli query gov authorized-voter cosmosvaloper1...
+`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			valAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			bz, err := cdc.MarshalJSON(types.NewQueryAuthorizedVoterParams(valAddr))
+			if err != nil {
+				return err
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryAuthorizedVoter), bz)
+			if err != nil {
+				return err
+			}
+
+			var auth types.VoterAuthorization
+			cdc.MustUnmarshalJSON(res, &auth)
+			return cliCtx.PrintOutput(auth)
+		},
+	}
+}
+
+// GetCmdQueryVoterValidator implements the query command for the validator which authorized a voter.
+func GetCmdQueryVoterValidator(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "voter-validator [voter-addr]",
+		Short: "Query the validator on behalf of which an account votes",
+		Long: strings.TrimSpace(`Query the validator on behalf of which an account votes:
+
+$ likecli query gov voter-validator cosmos1...
+`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			voter, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			bz, err := cdc.MarshalJSON(types.NewQueryVoterValidatorParams(voter))
+			if err != nil {
+				return err
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryVoterValidator), bz)
+			if err != nil {
+				return err
+			}
+
+			var auth types.VoterAuthorization
+			cdc.MustUnmarshalJSON(res, &auth)
+			return cliCtx.PrintOutput(auth)
+		},
+	}
+}
+
+// GetCmdQueryEmergencyProposals implements the query command for the emergency proposals being deposited or voted.
+func GetCmdQueryEmergencyProposals(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "emergency-proposals",
+		Short: "Query the IDs of the emergency proposals which are not yet tallied",
+		Long: strings.TrimSpace(`Query the IDs of the emergency proposals which are in deposit or voting period:
+
+$ likecli query gov emergency-proposals
+`),
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.Query(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryEmergencyProposals))
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+}
+
+// GetCmdQueryTallyResults implements the query command for the tally of a proposal in both tally modes.
+func GetCmdQueryTallyResults(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "tally-results [proposal-id]",
+		Short: "Query the current tally of a proposal in both stake weighted and one-validator-one-vote modes",
+		Long: strings.TrimSpace(`Query the current tally of a proposal which is not yet tallied, in both stake weighted and
+one-validator-one-vote modes. The mode applied to the proposal is given by the tally_mode in the wrapper params:
+
+$ likecli query gov tally-results 1
+`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid int, please input a valid proposal-id", args[0])
+			}
+			bz, err := cdc.MarshalJSON(gov.NewQueryProposalParams(proposalID))
+			if err != nil {
+				return err
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryTallyResults), bz)
+			if err != nil {
+				return err
+			}
+
+			var tallyResults types.TallyResults
+			cdc.MustUnmarshalJSON(res, &tallyResults)
+			return cliCtx.PrintOutput(tallyResults)
+		},
+	}
+}
+
+// GetCmdQueryProposerQuota implements the query command for the remaining proposal quota of a proposer.
+func GetCmdQueryProposerQuota(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "proposer-quota [proposer-addr]",
+		Short: "Query the number of proposals a proposer can still submit",
+		Long: strings.TrimSpace(`Query the active proposals and the proposals submitted in the proposal rate window by a proposer, and
+the number of proposals it can still submit under the limits in the wrapper params:
+
+$ likecli query gov proposer-quota cosmos1...
+`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			proposer, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			bz, err := cdc.MarshalJSON(types.NewQueryProposerQuotaParams(proposer))
+			if err != nil {
+				return err
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryProposerQuota), bz)
+			if err != nil {
+				return err
+			}
+
+			var quota types.ProposerQuota
+			cdc.MustUnmarshalJSON(res, &quota)
+			return cliCtx.PrintOutput(quota)
+		},
+	}
+}
+
+// GetCmdQueryProposerQuotas implements the query command for the remaining proposal quotas of all validators.
+func GetCmdQueryProposerQuotas(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "proposer-quotas",
+		Short: "Query the number of proposals each validator operator can still submit",
+		Long: strings.TrimSpace(`Query the proposal quotas of the operator accounts of all validators:
+
+$ likecli query gov proposer-quotas
+`),
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.Query(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryProposerQuotas))
+			if err != nil {
+				return err
+			}
+
+			var quotas types.ProposerQuotas
+			cdc.MustUnmarshalJSON(res, &quotas)
+			return cliCtx.PrintOutput(quotas)
+		},
+	}
+}
@@ -0,0 +1,143 @@
+package cli
+
+import (
+	"io/ioutil"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+
+	"github.com/likecoin/likechain/x/gov/types"
+)
+
+// GetTxCmds returns the wrapper specific transaction commands, which are added to the gov transaction command
+func GetTxCmds(cdc *codec.Codec) []*cobra.Command {
+	return client.PostCommands(
+		GetCmdAuthorizeVoter(cdc),
+		GetCmdRevokeVoter(cdc),
+		GetCmdSubmitEmergencyProposal(cdc),
+	)
+}
+
+// GetCmdAuthorizeVoter implements the authorize voter command
+func GetCmdAuthorizeVoter(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "authorize-voter [voter-addr]",
+		Short: "Authorize an account to vote on behalf of your validator",
+		Long: strings.TrimSpace(`Authorize an account to vote on behalf of your validator, so the operator key does not need to be
+kept online for governance. Votes from the account are recorded as the validator's votes. Authorizing a new account
+replaces the previous one.
+
+$ likecli tx gov authorize-voter cosmos1... --from mykey
+`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			voter, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			valAddr := sdk.ValAddress(cliCtx.GetFromAddress())
+
+			msg := types.NewMsgAuthorizeVoter(valAddr, voter)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.MarkFlagRequired(client.FlagFrom)
+
+	return cmd
+}
+
+// GetCmdRevokeVoter implements the revoke voter command
+func GetCmdRevokeVoter(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke-voter",
+		Short: "Revoke the account authorized to vote on behalf of your validator",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			valAddr := sdk.ValAddress(cliCtx.GetFromAddress())
+
+			msg := types.NewMsgRevokeVoter(valAddr)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.MarkFlagRequired(client.FlagFrom)
+
+	return cmd
+}
+
+// EmergencyProposalJSON defines the content of the proposal file for submit-emergency-proposal
+type EmergencyProposalJSON struct {
+	Content gov.Content `json:"content" yaml:"content"`
+	Deposit string      `json:"deposit" yaml:"deposit"`
+}
+
+// GetCmdSubmitEmergencyProposal implements the submit emergency proposal command
+func GetCmdSubmitEmergencyProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "submit-emergency-proposal [proposal-file]",
+		Short: "Submit an emergency proposal with a shorter voting period and a higher threshold",
+		Long: strings.TrimSpace(`Submit an emergency proposal from a bonded validator operator account. The proposal is tallied with the
+emergency voting period and threshold in the wrapper params, and takes effect as soon as the threshold is reached.
+The proposal file contains the amino JSON of the proposal content and the initial deposit:
+
+$ likecli tx gov submit-emergency-proposal proposal.json --from mykey
+
+Where proposal.json contains:
+
+{
+  "content": {
+    "type": "cosmos-sdk/TextProposal",
+    "value": {
+      "title": "Halt transfers",
+      "description": "..."
+    }
+  },
+  "deposit": "1000000nanolike"
+}
+`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			contents, err := ioutil.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+			var proposal EmergencyProposalJSON
+			err = cdc.UnmarshalJSON(contents, &proposal)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoins(proposal.Deposit)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSubmitEmergencyProposal(proposal.Content, deposit, cliCtx.GetFromAddress())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.MarkFlagRequired(client.FlagFrom)
+
+	return cmd
+}
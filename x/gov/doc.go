@@ -1,4 +1,29 @@
-// This is a wrapper on the x/gov module.
-// It wraps the MsgSubmitProposal handler to limit proposers to only validators
+// This is a wrapper on the x/gov module, which applies the governance rules of LikeChain on top of x/gov.
+//
+// The wrapper handler checks the gov messages before passing them to the x/gov handler. Proposers and voters are
+// limited by the participation modes in the wrapper params, which by default allow only bonded validators. Proposals
+// can also be limited by the number of active proposals of each proposer and the number of proposals each proposer
+// submits in a time window, both unlimited by default. Proposal content types are checked against the proposal type
+// lists: a type in the denylist is always rejected, and when the allowlist is not empty, only the types in it are
+// accepted. Note that an empty allowlist accepts every type routed by the gov router, so removing the last entry of
+// the allowlist opens up all types instead of closing them; use the denylist to block specific types.
+//
+// Validator operators can authorize a separate account to vote on behalf of their validators, so that the operator
+// keys can be kept offline. Votes of an authorized account are recorded as the votes of the validator, unless the
+// account has since created a validator of its own, in which case it votes for its own validator only.
+//
+// The wrapper takes over tallying from x/gov: its EndBlocker tallies the proposals whose voting periods have ended
+// before the x/gov EndBlocker runs, so that x/gov only drops the proposals without enough deposit. The tally follows
+// the rules of x/gov, with these extensions:
+//   - the quorum, threshold and veto can be overridden for specific proposal types;
+//   - votes are weighted by stake, optionally with the voting power of each validator capped (a cap too small to
+//     leave any voting power is ignored), or each bonded validator counts as one vote;
+//   - emergency proposals, which only bonded validators can submit, have a voting period shorter than that of x/gov
+//     and pass as soon as the Yes votes reach the emergency threshold of the total voting power.
+//
+// The gov tally query of proposals in voting period returns the tally of the wrapper rules.
+//
+// The wrapper state, i.e. the wrapper params, voter authorizations, proposal submissions and emergency proposal marks,
+// is stored in its own store, and is exported in the gov genesis state alongside the fields of x/gov.
 
 package gov
@@ -0,0 +1,63 @@
+package gov
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+)
+
+// SetEmergencyProposal marks the proposal as an emergency proposal
+func (k Keeper) SetEmergencyProposal(ctx sdk.Context, proposalID uint64) {
+	ctx.KVStore(k.storeKey).Set(EmergencyProposalKey(proposalID), []byte{1})
+}
+
+func (k Keeper) IsEmergencyProposal(ctx sdk.Context, proposalID uint64) bool {
+	return ctx.KVStore(k.storeKey).Has(EmergencyProposalKey(proposalID))
+}
+
+func (k Keeper) DeleteEmergencyProposal(ctx sdk.Context, proposalID uint64) {
+	ctx.KVStore(k.storeKey).Delete(EmergencyProposalKey(proposalID))
+}
+
+// IterateEmergencyProposals iterates over the emergency proposals which are not yet tallied or dropped
+func (k Keeper) IterateEmergencyProposals(ctx sdk.Context, cb func(proposalID uint64) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), EmergencyProposalKeyPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		if cb(SplitEmergencyProposalKey(iterator.Key())) {
+			break
+		}
+	}
+}
+
+func (k Keeper) GetEmergencyProposals(ctx sdk.Context) (proposalIDs []uint64) {
+	k.IterateEmergencyProposals(ctx, func(proposalID uint64) bool {
+		proposalIDs = append(proposalIDs, proposalID)
+		return false
+	})
+	return proposalIDs
+}
+
+// applyEmergencyVotingPeriod shortens the voting period of an emergency proposal which has entered voting period.
+// It is idempotent, since the end time is always computed from the voting start time. The period is never longer
+// than the gov voting period, even if a later param change makes the emergency voting period the longer one.
+func (k Keeper) applyEmergencyVotingPeriod(ctx sdk.Context, proposalID uint64) {
+	if !k.IsEmergencyProposal(ctx, proposalID) {
+		return
+	}
+	proposal, found := k.govKeeper.GetProposal(ctx, proposalID)
+	if !found || proposal.Status != gov.StatusVotingPeriod {
+		return
+	}
+	votingPeriod := k.EmergencyVotingPeriod(ctx)
+	if govVotingPeriod := k.govKeeper.GetVotingParams(ctx).VotingPeriod; govVotingPeriod < votingPeriod {
+		votingPeriod = govVotingPeriod
+	}
+	votingEndTime := proposal.VotingStartTime.Add(votingPeriod)
+	if votingEndTime.Equal(proposal.VotingEndTime) {
+		return
+	}
+	k.govKeeper.RemoveFromActiveProposalQueue(ctx, proposalID, proposal.VotingEndTime)
+	proposal.VotingEndTime = votingEndTime
+	k.govKeeper.SetProposal(ctx, proposal)
+	k.govKeeper.InsertActiveProposalQueue(ctx, proposalID, proposal.VotingEndTime)
+}
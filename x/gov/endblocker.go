@@ -0,0 +1,101 @@
+package gov
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// EndBlocker tallies the proposals with the wrapper rules before the x/gov EndBlocker runs, so that x/gov only needs
+// to handle the inactive proposals. Emergency proposals are tallied every block and finalized as soon as they pass.
+func EndBlocker(ctx sdk.Context, keeper Keeper) {
+	// clean up the states of proposals deleted by x/gov for not reaching the minimum deposit
+	keeper.govKeeper.IterateInactiveProposalsQueue(ctx, ctx.BlockHeader().Time, func(proposal gov.Proposal) bool {
+		keeper.DeleteEmergencyProposal(ctx, proposal.ProposalID)
+		keeper.deactivateProposalSubmission(ctx, proposal.ProposalID)
+		return false
+	})
+
+	for _, proposalID := range keeper.GetEmergencyProposals(ctx) {
+		proposal, found := keeper.govKeeper.GetProposal(ctx, proposalID)
+		if !found || proposal.Status != gov.StatusVotingPeriod {
+			continue
+		}
+		passes, _, tallyResults := keeper.Tally(ctx, proposal)
+		if passes {
+			keeper.govKeeper.RemoveFromActiveProposalQueue(ctx, proposal.ProposalID, proposal.VotingEndTime)
+			finalizeProposal(ctx, keeper, proposal, true, false, tallyResults)
+		}
+	}
+
+	// fetch active proposals whose voting periods have ended (are passed the block time)
+	keeper.govKeeper.IterateActiveProposalsQueue(ctx, ctx.BlockHeader().Time, func(proposal gov.Proposal) bool {
+		passes, burnDeposits, tallyResults := keeper.Tally(ctx, proposal)
+		keeper.govKeeper.RemoveFromActiveProposalQueue(ctx, proposal.ProposalID, proposal.VotingEndTime)
+		finalizeProposal(ctx, keeper, proposal, passes, burnDeposits, tallyResults)
+		return false
+	})
+}
+
+// finalizeProposal follows the x/gov EndBlocker on tallied proposals
+func finalizeProposal(ctx sdk.Context, keeper Keeper, proposal gov.Proposal, passes bool, burnDeposits bool,
+	tallyResults gov.TallyResult) {
+	var tagValue, logMsg string
+
+	keeper.deleteVotes(ctx, proposal.ProposalID)
+
+	if burnDeposits {
+		keeper.govKeeper.DeleteDeposits(ctx, proposal.ProposalID)
+	} else {
+		keeper.govKeeper.RefundDeposits(ctx, proposal.ProposalID)
+	}
+
+	if passes {
+		handler := keeper.govRouter.GetRoute(proposal.ProposalRoute())
+		cacheCtx, writeCache := ctx.CacheContext()
+
+		// The proposal handler may execute state mutating logic depending
+		// on the proposal content. If the handler fails, no state mutation
+		// is written and the error message is logged.
+		err := handler(cacheCtx, proposal.Content)
+		if err == nil {
+			proposal.Status = gov.StatusPassed
+			tagValue = govtypes.AttributeValueProposalPassed
+			logMsg = "passed"
+
+			// write state to the underlying multi-store
+			writeCache()
+		} else {
+			proposal.Status = gov.StatusFailed
+			tagValue = govtypes.AttributeValueProposalFailed
+			logMsg = fmt.Sprintf("passed, but failed on execution: %s", err.ABCILog())
+		}
+	} else {
+		proposal.Status = gov.StatusRejected
+		tagValue = govtypes.AttributeValueProposalRejected
+		logMsg = "rejected"
+	}
+
+	proposal.FinalTallyResult = tallyResults
+
+	keeper.govKeeper.SetProposal(ctx, proposal)
+	keeper.DeleteEmergencyProposal(ctx, proposal.ProposalID)
+	keeper.deactivateProposalSubmission(ctx, proposal.ProposalID)
+
+	keeper.Logger(ctx).Info(
+		fmt.Sprintf(
+			"proposal %d (%s) tallied; result: %s",
+			proposal.ProposalID, proposal.GetTitle(), logMsg,
+		),
+	)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			govtypes.EventTypeActiveProposal,
+			sdk.NewAttribute(govtypes.AttributeKeyProposalID, fmt.Sprintf("%d", proposal.ProposalID)),
+			sdk.NewAttribute(govtypes.AttributeKeyProposalResult, tagValue),
+		),
+	)
+}
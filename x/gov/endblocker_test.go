@@ -0,0 +1,157 @@
+package gov
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+)
+
+// createValidators creates validators with 40%, 30% and 30% of the voting power
+func createValidators(t *testing.T, input *testInput) []sdk.AccAddress {
+	return []sdk.AccAddress{
+		sdk.AccAddress(input.CreateValidator(t, 0, 40)),
+		sdk.AccAddress(input.CreateValidator(t, 1, 30)),
+		sdk.AccAddress(input.CreateValidator(t, 2, 30)),
+	}
+}
+
+func submitEmergencyProposal(t *testing.T, ctx sdk.Context, input *testInput, handler sdk.Handler,
+	proposer sdk.AccAddress) uint64 {
+	msg := NewMsgSubmitEmergencyProposal(textProposal(), minDeposit(ctx, input), proposer)
+	result := handler(ctx, msg)
+	require.True(t, result.IsOK(), result.Log)
+	var proposalID uint64
+	input.Cdc.MustUnmarshalBinaryLengthPrefixed(result.Data, &proposalID)
+	return proposalID
+}
+
+func requireProposalStatus(t *testing.T, ctx sdk.Context, input *testInput, proposalID uint64,
+	status gov.ProposalStatus) gov.Proposal {
+	proposal, found := input.GovKeeper.GetProposal(ctx, proposalID)
+	require.True(t, found)
+	require.Equal(t, status, proposal.Status)
+	return proposal
+}
+
+func votingPeriod(ctx sdk.Context, input *testInput) time.Duration {
+	return input.GovKeeper.GetVotingParams(ctx).VotingPeriod
+}
+
+func TestEndBlockerTalliesAtVotingEnd(t *testing.T) {
+	input, keeper, handler := createTestInput(t)
+	validators := createValidators(t, input)
+	ctx := input.Ctx
+	proposalID := submitTextProposal(t, ctx, input, handler, validators[0])
+	balance := input.BankKeeper.GetCoins(ctx, validators[0])
+
+	vote(t, ctx, handler, proposalID, validators[0], gov.OptionYes)
+	vote(t, ctx, handler, proposalID, validators[1], gov.OptionYes)
+
+	// a normal proposal is not tallied before its voting period ends, even with enough yes votes
+	ctx = input.NextBlock(time.Hour)
+	EndBlocker(ctx, keeper)
+	requireProposalStatus(t, ctx, input, proposalID, gov.StatusVotingPeriod)
+
+	ctx = input.NextBlock(votingPeriod(ctx, input))
+	EndBlocker(ctx, keeper)
+	proposal := requireProposalStatus(t, ctx, input, proposalID, gov.StatusPassed)
+	require.Equal(t, sdk.TokensFromConsensusPower(70), proposal.FinalTallyResult.Yes)
+	require.Equal(t, balance.Add(minDeposit(ctx, input)), input.BankKeeper.GetCoins(ctx, validators[0]))
+	_, found := input.GovKeeper.GetVote(ctx, proposalID, validators[0])
+	require.False(t, found)
+}
+
+func TestEndBlockerDeposits(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		votes   []gov.VoteOption
+		status  gov.ProposalStatus
+		refunds bool
+	}{
+		{"passed", []gov.VoteOption{gov.OptionYes, gov.OptionYes, gov.OptionNo}, gov.StatusPassed, true},
+		{"rejected", []gov.VoteOption{gov.OptionYes, gov.OptionNo, gov.OptionNo}, gov.StatusRejected, true},
+		{"no quorum", nil, gov.StatusRejected, false},
+		{"vetoed", []gov.VoteOption{gov.OptionNoWithVeto, gov.OptionYes, gov.OptionYes}, gov.StatusRejected, false},
+	} {
+		input, keeper, handler := createTestInput(t)
+		validators := createValidators(t, input)
+		ctx := input.Ctx
+		proposalID := submitTextProposal(t, ctx, input, handler, validators[0])
+		balance := input.BankKeeper.GetCoins(ctx, validators[0])
+		supply := input.SupplyKeeper.GetSupply(ctx).GetTotal()
+		for i, option := range tc.votes {
+			vote(t, ctx, handler, proposalID, validators[i], option)
+		}
+
+		ctx = input.NextBlock(votingPeriod(ctx, input))
+		EndBlocker(ctx, keeper)
+		requireProposalStatus(t, ctx, input, proposalID, tc.status)
+		_, found := input.GovKeeper.GetDeposit(ctx, proposalID, validators[0])
+		require.False(t, found, tc.name)
+		if tc.refunds {
+			require.Equal(t, balance.Add(minDeposit(ctx, input)), input.BankKeeper.GetCoins(ctx, validators[0]), tc.name)
+			require.Equal(t, supply, input.SupplyKeeper.GetSupply(ctx).GetTotal(), tc.name)
+		} else {
+			require.Equal(t, balance, input.BankKeeper.GetCoins(ctx, validators[0]), tc.name)
+			require.Equal(t, supply.Sub(minDeposit(ctx, input)), input.SupplyKeeper.GetSupply(ctx).GetTotal(), tc.name)
+		}
+	}
+}
+
+func TestEndBlockerPassesEmergencyProposalEarly(t *testing.T) {
+	input, keeper, handler := createTestInput(t)
+	validators := createValidators(t, input)
+	ctx := input.Ctx
+	proposalID := submitEmergencyProposal(t, ctx, input, handler, validators[0])
+	proposal := requireProposalStatus(t, ctx, input, proposalID, gov.StatusVotingPeriod)
+	require.Equal(t, proposal.VotingStartTime.Add(keeper.EmergencyVotingPeriod(ctx)), proposal.VotingEndTime)
+
+	// 40% of the voting power is below the emergency threshold, even though all the votes are yes
+	vote(t, ctx, handler, proposalID, validators[0], gov.OptionYes)
+	ctx = input.NextBlock(time.Minute)
+	EndBlocker(ctx, keeper)
+	requireProposalStatus(t, ctx, input, proposalID, gov.StatusVotingPeriod)
+
+	vote(t, ctx, handler, proposalID, validators[1], gov.OptionYes)
+	ctx = input.NextBlock(time.Minute)
+	EndBlocker(ctx, keeper)
+	requireProposalStatus(t, ctx, input, proposalID, gov.StatusPassed)
+	require.False(t, keeper.IsEmergencyProposal(ctx, proposalID))
+
+	// the proposal is removed from the active queue, so it is not tallied again at its voting end time
+	ctx = input.NextBlock(keeper.EmergencyVotingPeriod(ctx))
+	EndBlocker(ctx, keeper)
+	requireProposalStatus(t, ctx, input, proposalID, gov.StatusPassed)
+}
+
+func TestEndBlockerRejectsEmergencyProposalAtVotingEnd(t *testing.T) {
+	input, keeper, handler := createTestInput(t)
+	validators := createValidators(t, input)
+	ctx := input.Ctx
+	proposalID := submitEmergencyProposal(t, ctx, input, handler, validators[0])
+
+	// a majority of the votes, which is enough for a normal proposal, is not enough for an emergency proposal
+	vote(t, ctx, handler, proposalID, validators[0], gov.OptionYes)
+	vote(t, ctx, handler, proposalID, validators[1], gov.OptionNo)
+
+	ctx = input.NextBlock(keeper.EmergencyVotingPeriod(ctx))
+	EndBlocker(ctx, keeper)
+	requireProposalStatus(t, ctx, input, proposalID, gov.StatusRejected)
+	require.False(t, keeper.IsEmergencyProposal(ctx, proposalID))
+}
+
+func TestEmergencyVotingPeriodCappedByGovVotingPeriod(t *testing.T) {
+	input, keeper, handler := createTestInput(t)
+	validators := createValidators(t, input)
+	ctx := input.Ctx
+	setParams(ctx, keeper, func(params *Params) {
+		params.EmergencyVotingPeriod = 2 * votingPeriod(ctx, input)
+	})
+	proposalID := submitEmergencyProposal(t, ctx, input, handler, validators[0])
+	proposal := requireProposalStatus(t, ctx, input, proposalID, gov.StatusVotingPeriod)
+	require.Equal(t, proposal.VotingStartTime.Add(votingPeriod(ctx, input)), proposal.VotingEndTime)
+}
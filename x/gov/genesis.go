@@ -0,0 +1,64 @@
+package gov
+
+import (
+	"encoding/json"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func InitGenesis(ctx sdk.Context, keeper Keeper, genesisState GenesisState) {
+	keeper.SetParams(ctx, genesisState.Params)
+	for _, auth := range genesisState.VoterAuthorizations {
+		keeper.SetAuthorizedVoter(ctx, auth.ValidatorAddress, auth.Voter)
+	}
+	for _, submission := range genesisState.ProposalSubmissions {
+		keeper.SetProposalSubmission(ctx, submission)
+	}
+	for _, proposalID := range genesisState.EmergencyProposals {
+		keeper.SetEmergencyProposal(ctx, proposalID)
+	}
+}
+
+func ExportGenesis(ctx sdk.Context, keeper Keeper) GenesisState {
+	params := keeper.GetParams(ctx)
+	voterAuthorizations := keeper.GetVoterAuthorizations(ctx)
+	proposalSubmissions := keeper.GetProposalSubmissions(ctx)
+	emergencyProposals := keeper.GetEmergencyProposals(ctx)
+	return GenesisState{
+		Params:              params,
+		VoterAuthorizations: voterAuthorizations,
+		ProposalSubmissions: proposalSubmissions,
+		EmergencyProposals:  emergencyProposals,
+	}
+}
+
+// The wrapper genesis state shares the same JSON object with the original gov genesis state, so that the genesis
+// file layout stays compatible. Fields missing from the JSON (e.g. genesis exported before the wrapper has its own
+// state) fall back to the default values.
+
+func unmarshalGenesis(bz json.RawMessage) (GenesisState, error) {
+	data := DefaultGenesisState()
+	err := ModuleCdc.UnmarshalJSON(bz, &data)
+	return data, err
+}
+
+func mustMergeGenesis(govGenesis json.RawMessage, data GenesisState) json.RawMessage {
+	fields := map[string]json.RawMessage{}
+	err := json.Unmarshal(govGenesis, &fields)
+	if err != nil {
+		panic(err)
+	}
+	wrapperFields := map[string]json.RawMessage{}
+	err = json.Unmarshal(ModuleCdc.MustMarshalJSON(data), &wrapperFields)
+	if err != nil {
+		panic(err)
+	}
+	for k, v := range wrapperFields {
+		fields[k] = v
+	}
+	bz, err := json.Marshal(fields)
+	if err != nil {
+		panic(err)
+	}
+	return bz
+}
@@ -0,0 +1,70 @@
+package gov
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/x/gov"
+)
+
+func TestExportImportEmergencyProposals(t *testing.T) {
+	input, keeper, handler := createTestInput(t)
+	validators := createValidators(t, input)
+	ctx := input.Ctx
+	submitTextProposal(t, ctx, input, handler, validators[0])
+	proposalID := submitEmergencyProposal(t, ctx, input, handler, validators[0])
+
+	exported := ExportGenesis(ctx, keeper)
+	require.Equal(t, []uint64{proposalID}, exported.EmergencyProposals)
+	govExported := gov.ExportGenesis(ctx, input.GovKeeper)
+	require.NoError(t, ValidateGenesis(exported, govExported))
+
+	// the exported state is imported into a new chain through the merged gov genesis JSON
+	bz := mustMergeGenesis(gov.ModuleCdc.MustMarshalJSON(govExported), exported)
+	require.NoError(t, AppModuleBasic{}.ValidateGenesis(bz))
+	imported, err := unmarshalGenesis(bz)
+	require.NoError(t, err)
+
+	newInput, newKeeper, _ := createTestInput(t)
+	InitGenesis(newInput.Ctx, newKeeper, imported)
+	require.True(t, newKeeper.IsEmergencyProposal(newInput.Ctx, proposalID))
+	require.False(t, newKeeper.IsEmergencyProposal(newInput.Ctx, proposalID-1))
+	require.Equal(t, exported, ExportGenesis(newInput.Ctx, newKeeper))
+}
+
+func TestValidateGenesisEmergencyProposals(t *testing.T) {
+	input, keeper, handler := createTestInput(t)
+	validators := createValidators(t, input)
+	ctx := input.Ctx
+	proposalID := submitEmergencyProposal(t, ctx, input, handler, validators[0])
+	govData := gov.ExportGenesis(ctx, input.GovKeeper)
+
+	data := ExportGenesis(ctx, keeper)
+	data.EmergencyProposals = []uint64{proposalID, proposalID}
+	require.Error(t, ValidateGenesis(data, govData))
+
+	data.EmergencyProposals = []uint64{proposalID + 1}
+	require.Error(t, ValidateGenesis(data, govData))
+
+	// tallied proposals are no longer emergency proposals
+	data.EmergencyProposals = []uint64{proposalID}
+	govData.Proposals[0].Status = gov.StatusPassed
+	require.Error(t, ValidateGenesis(data, govData))
+}
+
+func TestValidateGenesisEmergencyVotingPeriod(t *testing.T) {
+	govData := gov.DefaultGenesisState()
+	data := DefaultGenesisState()
+	require.NoError(t, ValidateGenesis(data, govData))
+
+	data.Params.EmergencyVotingPeriod = govData.VotingParams.VotingPeriod
+	require.Error(t, ValidateGenesis(data, govData))
+
+	// the gov voting period is read from the gov part of the merged genesis JSON
+	data = DefaultGenesisState()
+	govData.VotingParams.VotingPeriod = data.Params.EmergencyVotingPeriod / 2
+	bz := mustMergeGenesis(gov.ModuleCdc.MustMarshalJSON(govData), data)
+	require.Error(t, AppModuleBasic{}.ValidateGenesis(json.RawMessage(bz)))
+}
@@ -0,0 +1,165 @@
+package gov
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+)
+
+// WrapGovHandler returns a handler which handles the wrapper messages, and checks MsgSubmitProposal and MsgVote
+// before passing them to the original gov handler
+func WrapGovHandler(keeper Keeper, govHandler sdk.Handler) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+		switch msg := msg.(type) {
+		case MsgAuthorizeVoter:
+			return handleMsgAuthorizeVoter(ctx, msg, keeper)
+		case MsgRevokeVoter:
+			return handleMsgRevokeVoter(ctx, msg, keeper)
+		case MsgSubmitEmergencyProposal:
+			return handleMsgSubmitEmergencyProposal(ctx, msg, keeper, govHandler)
+		case gov.MsgSubmitProposal:
+			result, _ := submitProposal(ctx, msg, keeper, govHandler)
+			return result
+		case gov.MsgDeposit:
+			result := govHandler(ctx, msg)
+			if result.IsOK() {
+				// the deposit may have moved an emergency proposal into voting period
+				keeper.applyEmergencyVotingPeriod(ctx, msg.ProposalID)
+			}
+			return result
+		case gov.MsgVote:
+			return handleMsgVote(ctx, msg, keeper, govHandler)
+		}
+		return govHandler(ctx, msg)
+	}
+}
+
+// submitProposal checks the proposer, the proposal type and the proposer quota before passing the message to the gov
+// handler, and records the submission if the proposal is submitted
+func submitProposal(ctx sdk.Context, msg gov.MsgSubmitProposal, keeper Keeper, govHandler sdk.Handler) (
+	result sdk.Result, proposalID uint64) {
+	if !keeper.CanSubmitProposal(ctx, msg.Proposer) {
+		return ErrProposerNotAllowed(keeper.Codespace(), keeper.ProposerMode(ctx)).Result(), 0
+	}
+	proposalType := NewProposalType(msg.Content.ProposalRoute(), msg.Content.ProposalType())
+	if !keeper.IsProposalTypeAllowed(ctx, proposalType) {
+		return ErrProposalTypeNotAllowed(keeper.Codespace(), proposalType).Result(), 0
+	}
+	if err := keeper.checkProposerQuota(ctx, msg.Proposer); err != nil {
+		return err.Result(), 0
+	}
+
+	result = govHandler(ctx, msg)
+	if !result.IsOK() {
+		return result, 0
+	}
+	keeper.cdc.MustUnmarshalBinaryLengthPrefixed(result.Data, &proposalID)
+	keeper.recordProposalSubmission(ctx, msg.Proposer, proposalID)
+	return result, proposalID
+}
+
+func handleMsgSubmitEmergencyProposal(ctx sdk.Context, msg MsgSubmitEmergencyProposal, keeper Keeper,
+	govHandler sdk.Handler) sdk.Result {
+	// emergency proposals are limited to bonded validators on top of the checks on all proposals
+	if !keeper.isBondedValidator(ctx, msg.Proposer) {
+		return ErrEmergencyProposerNotValidator(keeper.Codespace()).Result()
+	}
+	result, proposalID := submitProposal(ctx, msg.ToMsgSubmitProposal(), keeper, govHandler)
+	if !result.IsOK() {
+		return result
+	}
+
+	keeper.SetEmergencyProposal(ctx, proposalID)
+	keeper.applyEmergencyVotingPeriod(ctx, proposalID)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeEmergencyProposal,
+			sdk.NewAttribute(AttributeKeyProposalID, fmt.Sprintf("%d", proposalID)),
+		),
+	)
+	result.Events = result.Events.AppendEvents(ctx.EventManager().Events())
+	return result
+}
+
+func handleMsgVote(ctx sdk.Context, msg gov.MsgVote, keeper Keeper, govHandler sdk.Handler) sdk.Result {
+	voter, delegated := keeper.EffectiveVoter(ctx, msg.Voter)
+	if !keeper.CanVote(ctx, voter) {
+		return ErrVoterNotAllowed(keeper.Codespace(), keeper.VoterMode(ctx)).Result()
+	}
+	if !delegated {
+		return govHandler(ctx, msg)
+	}
+
+	// the vote is recorded as the validator's own vote, so it is attributed to the validator in tally
+	delegatedVoter := msg.Voter
+	msg.Voter = voter
+	result := govHandler(ctx, msg)
+	if !result.IsOK() {
+		return result
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeDelegatedVote,
+			sdk.NewAttribute(AttributeKeyValidator, sdk.ValAddress(voter).String()),
+			sdk.NewAttribute(AttributeKeyVoter, delegatedVoter.String()),
+		),
+	)
+	result.Events = result.Events.AppendEvents(ctx.EventManager().Events())
+	return result
+}
+
+func handleMsgAuthorizeVoter(ctx sdk.Context, msg MsgAuthorizeVoter, keeper Keeper) sdk.Result {
+	if keeper.stakingKeeper.Validator(ctx, msg.ValidatorAddress) == nil {
+		return ErrInvalidValidator(keeper.Codespace()).Result()
+	}
+	// a validator voting for another validator would make the attribution ambiguous
+	if keeper.stakingKeeper.Validator(ctx, sdk.ValAddress(msg.Voter)) != nil {
+		return ErrInvalidVoter(keeper.Codespace()).Result()
+	}
+	valAddr, found := keeper.GetVoterValidator(ctx, msg.Voter)
+	if found && !valAddr.Equals(msg.ValidatorAddress) {
+		return ErrVoterAlreadyAuthorized(keeper.Codespace()).Result()
+	}
+	keeper.SetAuthorizedVoter(ctx, msg.ValidatorAddress, msg.Voter)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			EventTypeAuthorizeVoter,
+			sdk.NewAttribute(AttributeKeyValidator, msg.ValidatorAddress.String()),
+			sdk.NewAttribute(AttributeKeyVoter, msg.Voter.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, sdk.AccAddress(msg.ValidatorAddress).String()),
+		),
+	})
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+func handleMsgRevokeVoter(ctx sdk.Context, msg MsgRevokeVoter, keeper Keeper) sdk.Result {
+	voter, found := keeper.GetAuthorizedVoter(ctx, msg.ValidatorAddress)
+	if !found {
+		return ErrVoterNotFound(keeper.Codespace()).Result()
+	}
+	keeper.DeleteAuthorizedVoter(ctx, msg.ValidatorAddress)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			EventTypeRevokeVoter,
+			sdk.NewAttribute(AttributeKeyValidator, msg.ValidatorAddress.String()),
+			sdk.NewAttribute(AttributeKeyVoter, voter.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, sdk.AccAddress(msg.ValidatorAddress).String()),
+		),
+	})
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
@@ -0,0 +1,188 @@
+package gov
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+
+	"github.com/likecoin/likechain/x/gov/types"
+)
+
+func TestSubmitProposalByProposerMode(t *testing.T) {
+	input, keeper, handler := createTestInput(t)
+	ctx := input.Ctx
+	validator := sdk.AccAddress(input.CreateValidator(t, 0, 100))
+
+	result := handler(ctx, gov.NewMsgSubmitProposal(textProposal(), minDeposit(ctx, input), addrs[1]))
+	require.Equal(t, types.CodeProposerNotAllowed, result.Code)
+	require.Equal(t, DefaultCodespace, result.Codespace)
+
+	submitTextProposal(t, ctx, input, handler, validator)
+
+	setParams(ctx, keeper, func(params *Params) {
+		params.ProposerMode = ModeAnyAccount
+	})
+	submitTextProposal(t, ctx, input, handler, addrs[1])
+}
+
+func TestSubmitEmergencyProposalByProposerMode(t *testing.T) {
+	input, keeper, handler := createTestInput(t)
+	ctx := input.Ctx
+	validator := sdk.AccAddress(input.CreateValidator(t, 0, 100))
+	allowedValidator := sdk.AccAddress(input.CreateValidator(t, 1, 100))
+
+	result := handler(ctx, NewMsgSubmitEmergencyProposal(textProposal(), minDeposit(ctx, input), addrs[2]))
+	require.Equal(t, types.CodeProposerNotAllowed, result.Code)
+
+	// a bonded validator excluded by the allowlist cannot bypass it with an emergency proposal
+	setParams(ctx, keeper, func(params *Params) {
+		params.ProposerMode = ModeAllowlist
+		params.ProposerAllowlist = []sdk.AccAddress{allowedValidator}
+	})
+	result = handler(ctx, NewMsgSubmitEmergencyProposal(textProposal(), minDeposit(ctx, input), validator))
+	require.Equal(t, types.CodeProposerNotAllowed, result.Code)
+	require.Equal(t, DefaultCodespace, result.Codespace)
+	_, found := input.GovKeeper.GetProposal(ctx, 1)
+	require.False(t, found)
+
+	proposalID := submitEmergencyProposal(t, ctx, input, handler, allowedValidator)
+	require.True(t, keeper.IsEmergencyProposal(ctx, proposalID))
+}
+
+func TestVoteByVoterMode(t *testing.T) {
+	input, keeper, handler := createTestInput(t)
+	ctx := input.Ctx
+	validator := sdk.AccAddress(input.CreateValidator(t, 0, 100))
+	proposalID := submitTextProposal(t, ctx, input, handler, validator)
+
+	result := handler(ctx, gov.NewMsgVote(addrs[1], proposalID, gov.OptionYes))
+	require.Equal(t, types.CodeVoterNotAllowed, result.Code)
+	_, found := input.GovKeeper.GetVote(ctx, proposalID, addrs[1])
+	require.False(t, found)
+
+	vote(t, ctx, handler, proposalID, validator, gov.OptionYes)
+
+	setParams(ctx, keeper, func(params *Params) {
+		params.VoterMode = ModeAllowlist
+		params.VoterAllowlist = []sdk.AccAddress{addrs[1]}
+	})
+	vote(t, ctx, handler, proposalID, addrs[1], gov.OptionNo)
+	result = handler(ctx, gov.NewMsgVote(validator, proposalID, gov.OptionNo))
+	require.Equal(t, types.CodeVoterNotAllowed, result.Code)
+}
+
+func TestAuthorizeVoter(t *testing.T) {
+	input, _, handler := createTestInput(t)
+	ctx := input.Ctx
+	valAddr := input.CreateValidator(t, 0, 100)
+	otherValAddr := input.CreateValidator(t, 1, 100)
+	voter := addrs[2]
+
+	result := handler(ctx, NewMsgAuthorizeVoter(sdk.ValAddress(addrs[3]), voter))
+	require.Equal(t, types.CodeInvalidValidator, result.Code)
+
+	result = handler(ctx, NewMsgAuthorizeVoter(valAddr, sdk.AccAddress(otherValAddr)))
+	require.Equal(t, types.CodeInvalidVoter, result.Code)
+
+	result = handler(ctx, NewMsgAuthorizeVoter(valAddr, voter))
+	require.True(t, result.IsOK(), result.Log)
+	require.Equal(t, EventTypeAuthorizeVoter, result.Events[0].Type)
+
+	// authorizing the same voter again is allowed, but not by another validator
+	result = handler(ctx, NewMsgAuthorizeVoter(valAddr, voter))
+	require.True(t, result.IsOK(), result.Log)
+	result = handler(ctx, NewMsgAuthorizeVoter(otherValAddr, voter))
+	require.Equal(t, types.CodeInvalidVoter, result.Code)
+}
+
+func TestVoteAsAuthorizedVoter(t *testing.T) {
+	input, _, handler := createTestInput(t)
+	ctx := input.Ctx
+	valAddr := input.CreateValidator(t, 0, 100)
+	voter := addrs[2]
+	proposalID := submitTextProposal(t, ctx, input, handler, sdk.AccAddress(valAddr))
+
+	result := handler(ctx, NewMsgAuthorizeVoter(valAddr, voter))
+	require.True(t, result.IsOK(), result.Log)
+
+	result = handler(ctx, gov.NewMsgVote(voter, proposalID, gov.OptionNo))
+	require.True(t, result.IsOK(), result.Log)
+	delegatedVote := false
+	for _, event := range result.Events {
+		delegatedVote = delegatedVote || event.Type == EventTypeDelegatedVote
+	}
+	require.True(t, delegatedVote)
+
+	validatorVote, found := input.GovKeeper.GetVote(ctx, proposalID, sdk.AccAddress(valAddr))
+	require.True(t, found)
+	require.Equal(t, gov.OptionNo, validatorVote.Option)
+	_, found = input.GovKeeper.GetVote(ctx, proposalID, voter)
+	require.False(t, found)
+}
+
+func TestRevokeVoter(t *testing.T) {
+	input, keeper, handler := createTestInput(t)
+	ctx := input.Ctx
+	valAddr := input.CreateValidator(t, 0, 100)
+	voter := addrs[2]
+	proposalID := submitTextProposal(t, ctx, input, handler, sdk.AccAddress(valAddr))
+
+	result := handler(ctx, NewMsgRevokeVoter(valAddr))
+	require.Equal(t, types.CodeVoterNotFound, result.Code)
+
+	result = handler(ctx, NewMsgAuthorizeVoter(valAddr, voter))
+	require.True(t, result.IsOK(), result.Log)
+	result = handler(ctx, NewMsgRevokeVoter(valAddr))
+	require.True(t, result.IsOK(), result.Log)
+	require.Equal(t, EventTypeRevokeVoter, result.Events[0].Type)
+	_, found := keeper.GetAuthorizedVoter(ctx, valAddr)
+	require.False(t, found)
+
+	// the revoked voter is back to an ordinary account, which cannot vote in the default voter mode
+	result = handler(ctx, gov.NewMsgVote(voter, proposalID, gov.OptionYes))
+	require.Equal(t, types.CodeVoterNotAllowed, result.Code)
+	_, found = input.GovKeeper.GetVote(ctx, proposalID, sdk.AccAddress(valAddr))
+	require.False(t, found)
+}
+
+func TestAuthorizedVoterBecomingValidator(t *testing.T) {
+	input, _, handler := createTestInput(t)
+	ctx := input.Ctx
+	valAddr := input.CreateValidator(t, 0, 100)
+	proposalID := submitTextProposal(t, ctx, input, handler, sdk.AccAddress(valAddr))
+
+	result := handler(ctx, NewMsgAuthorizeVoter(valAddr, addrs[2]))
+	require.True(t, result.IsOK(), result.Log)
+	voter := sdk.AccAddress(input.CreateValidator(t, 2, 10))
+
+	// the vote is the voter's own, instead of overwriting the vote of the authorizing validator
+	vote(t, ctx, handler, proposalID, voter, gov.OptionNo)
+	_, found := input.GovKeeper.GetVote(ctx, proposalID, voter)
+	require.True(t, found)
+	_, found = input.GovKeeper.GetVote(ctx, proposalID, sdk.AccAddress(valAddr))
+	require.False(t, found)
+}
+
+func TestSubmitProposalByProposalType(t *testing.T) {
+	input, keeper, handler := createTestInput(t)
+	ctx := input.Ctx
+	validator := sdk.AccAddress(input.CreateValidator(t, 0, 100))
+	text := NewProposalType(gov.RouterKey, gov.ProposalTypeText)
+
+	setParams(ctx, keeper, func(params *Params) {
+		params.ProposalTypeDenylist = []ProposalType{text}
+	})
+	result := handler(ctx, gov.NewMsgSubmitProposal(textProposal(), minDeposit(ctx, input), validator))
+	require.Equal(t, types.CodeProposalTypeNotAllowed, result.Code)
+	result = handler(ctx, NewMsgSubmitEmergencyProposal(textProposal(), minDeposit(ctx, input), validator))
+	require.Equal(t, types.CodeProposalTypeNotAllowed, result.Code)
+
+	setParams(ctx, keeper, func(params *Params) {
+		params.ProposalTypeAllowlist = []ProposalType{text}
+		params.ProposalTypeDenylist = nil
+	})
+	submitTextProposal(t, ctx, input, handler, validator)
+}
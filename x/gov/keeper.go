@@ -0,0 +1,220 @@
+package gov
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+	"github.com/cosmos/cosmos-sdk/x/params"
+)
+
+const (
+	DefaultParamspace = ModuleName
+)
+
+type Keeper struct {
+	storeKey      sdk.StoreKey
+	cdc           *codec.Codec
+	paramstore    params.Subspace
+	stakingKeeper StakingKeeper
+	codespace     sdk.CodespaceType
+
+	// the wrapper takes over tallying from gov, so it needs the gov store (for deleting tallied votes) and router
+	govKeeper   gov.Keeper
+	govStoreKey sdk.StoreKey
+	govRouter   gov.Router
+}
+
+func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, paramstore params.Subspace, stakingKeeper StakingKeeper,
+	govKeeper gov.Keeper, govStoreKey sdk.StoreKey, govRouter gov.Router, codespace sdk.CodespaceType) Keeper {
+	return Keeper{
+		storeKey:      key,
+		cdc:           cdc,
+		paramstore:    paramstore.WithKeyTable(ParamKeyTable()),
+		stakingKeeper: stakingKeeper,
+		codespace:     codespace,
+		govKeeper:     govKeeper,
+		govStoreKey:   govStoreKey,
+		govRouter:     govRouter,
+	}
+}
+
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", ModuleName))
+}
+
+func (k Keeper) Codespace() sdk.CodespaceType {
+	return k.codespace
+}
+
+func ParamKeyTable() params.KeyTable {
+	return params.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+func (k Keeper) ProposerMode(ctx sdk.Context) (res ParticipationMode) {
+	k.paramstore.Get(ctx, KeyProposerMode, &res)
+	return
+}
+
+func (k Keeper) VoterMode(ctx sdk.Context) (res ParticipationMode) {
+	k.paramstore.Get(ctx, KeyVoterMode, &res)
+	return
+}
+
+func (k Keeper) ProposerAllowlist(ctx sdk.Context) (res []sdk.AccAddress) {
+	k.paramstore.Get(ctx, KeyProposerAllowlist, &res)
+	return
+}
+
+func (k Keeper) VoterAllowlist(ctx sdk.Context) (res []sdk.AccAddress) {
+	k.paramstore.Get(ctx, KeyVoterAllowlist, &res)
+	return
+}
+
+func (k Keeper) ProposalTypeAllowlist(ctx sdk.Context) (res []ProposalType) {
+	k.paramstore.Get(ctx, KeyProposalTypeAllowlist, &res)
+	return
+}
+
+func (k Keeper) ProposalTypeDenylist(ctx sdk.Context) (res []ProposalType) {
+	k.paramstore.Get(ctx, KeyProposalTypeDenylist, &res)
+	return
+}
+
+func (k Keeper) EmergencyVotingPeriod(ctx sdk.Context) (res time.Duration) {
+	k.paramstore.Get(ctx, KeyEmergencyVotingPeriod, &res)
+	return
+}
+
+func (k Keeper) EmergencyThreshold(ctx sdk.Context) (res sdk.Dec) {
+	k.paramstore.Get(ctx, KeyEmergencyThreshold, &res)
+	return
+}
+
+func (k Keeper) ProposalTallyParams(ctx sdk.Context) (res []ProposalTallyParams) {
+	k.paramstore.Get(ctx, KeyProposalTallyParams, &res)
+	return
+}
+
+// TallyParams returns the tally params for the proposal type, falling back to the gov tally params
+func (k Keeper) TallyParams(ctx sdk.Context, proposalType ProposalType) gov.TallyParams {
+	for _, tallyParams := range k.ProposalTallyParams(ctx) {
+		if tallyParams.ProposalType == proposalType {
+			return tallyParams.TallyParams
+		}
+	}
+	return k.govKeeper.GetTallyParams(ctx)
+}
+
+func (k Keeper) TallyMode(ctx sdk.Context) (res TallyMode) {
+	k.paramstore.Get(ctx, KeyTallyMode, &res)
+	return
+}
+
+func (k Keeper) ValidatorPowerCap(ctx sdk.Context) (res sdk.Dec) {
+	k.paramstore.Get(ctx, KeyValidatorPowerCap, &res)
+	return
+}
+
+func (k Keeper) MaxActiveProposals(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, KeyMaxActiveProposals, &res)
+	return
+}
+
+func (k Keeper) MaxProposalsPerWindow(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, KeyMaxProposalsPerWindow, &res)
+	return
+}
+
+func (k Keeper) ProposalRateWindow(ctx sdk.Context) (res time.Duration) {
+	k.paramstore.Get(ctx, KeyProposalRateWindow, &res)
+	return
+}
+
+func (k Keeper) GetParams(ctx sdk.Context) Params {
+	return Params{
+		ProposerMode:          k.ProposerMode(ctx),
+		VoterMode:             k.VoterMode(ctx),
+		ProposerAllowlist:     k.ProposerAllowlist(ctx),
+		VoterAllowlist:        k.VoterAllowlist(ctx),
+		ProposalTypeAllowlist: k.ProposalTypeAllowlist(ctx),
+		ProposalTypeDenylist:  k.ProposalTypeDenylist(ctx),
+		EmergencyVotingPeriod: k.EmergencyVotingPeriod(ctx),
+		EmergencyThreshold:    k.EmergencyThreshold(ctx),
+		ProposalTallyParams:   k.ProposalTallyParams(ctx),
+		TallyMode:             k.TallyMode(ctx),
+		ValidatorPowerCap:     k.ValidatorPowerCap(ctx),
+		MaxActiveProposals:    k.MaxActiveProposals(ctx),
+		MaxProposalsPerWindow: k.MaxProposalsPerWindow(ctx),
+		ProposalRateWindow:    k.ProposalRateWindow(ctx),
+	}
+}
+
+func (k Keeper) SetParams(ctx sdk.Context, params Params) {
+	k.paramstore.SetParamSet(ctx, &params)
+}
+
+// The operator address of a validator shares the same bytes with the account address of its operator
+func (k Keeper) isBondedValidator(ctx sdk.Context, addr sdk.AccAddress) bool {
+	validator := k.stakingKeeper.Validator(ctx, sdk.ValAddress(addr))
+	return validator != nil && validator.IsBonded()
+}
+
+func (k Keeper) isValidator(ctx sdk.Context, addr sdk.AccAddress) bool {
+	return k.stakingKeeper.Validator(ctx, sdk.ValAddress(addr)) != nil
+}
+
+func (k Keeper) checkParticipation(ctx sdk.Context, mode ParticipationMode, allowlist []sdk.AccAddress, addr sdk.AccAddress) bool {
+	switch mode {
+	case ModeBondedValidators:
+		return k.isBondedValidator(ctx, addr)
+	case ModeValidators:
+		return k.isValidator(ctx, addr)
+	case ModeAnyAccount:
+		return true
+	case ModeAllowlist:
+		for _, allowed := range allowlist {
+			if allowed.Equals(addr) {
+				return true
+			}
+		}
+		return false
+	default:
+		// unknown modes (e.g. set by a malformed parameter change proposal) allow nobody
+		return false
+	}
+}
+
+// CanSubmitProposal returns whether the address is allowed to submit proposals under the current params
+func (k Keeper) CanSubmitProposal(ctx sdk.Context, addr sdk.AccAddress) bool {
+	return k.checkParticipation(ctx, k.ProposerMode(ctx), k.ProposerAllowlist(ctx), addr)
+}
+
+// CanVote returns whether the address is allowed to vote under the current params
+func (k Keeper) CanVote(ctx sdk.Context, addr sdk.AccAddress) bool {
+	return k.checkParticipation(ctx, k.VoterMode(ctx), k.VoterAllowlist(ctx), addr)
+}
+
+// IsProposalTypeAllowed returns whether the proposal type is not in the denylist and is in the allowlist, where an
+// empty allowlist allows all types
+func (k Keeper) IsProposalTypeAllowed(ctx sdk.Context, proposalType ProposalType) bool {
+	for _, denied := range k.ProposalTypeDenylist(ctx) {
+		if denied == proposalType {
+			return false
+		}
+	}
+	allowlist := k.ProposalTypeAllowlist(ctx)
+	if len(allowlist) == 0 {
+		return true
+	}
+	for _, allowed := range allowlist {
+		if allowed == proposalType {
+			return true
+		}
+	}
+	return false
+}
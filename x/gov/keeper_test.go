@@ -0,0 +1,182 @@
+package gov
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	"github.com/cosmos/cosmos-sdk/x/staking/exported"
+)
+
+func TestCheckParticipation(t *testing.T) {
+	input, keeper, _ := createTestInput(t)
+	ctx := input.Ctx
+	bonded := sdk.AccAddress(input.CreateValidator(t, 0, 100))
+	// the validator set is filled by a validator with more power, so the validator created after it stays unbonded
+	stakingParams := input.StakingKeeper.GetParams(ctx)
+	stakingParams.MaxValidators = 1
+	input.StakingKeeper.SetParams(ctx, stakingParams)
+	unbonded := sdk.AccAddress(input.CreateValidator(t, 1, 10))
+	account := addrs[2]
+
+	validator, _ := input.StakingKeeper.GetValidator(ctx, sdk.ValAddress(unbonded))
+	require.Equal(t, sdk.Unbonded, validator.Status)
+
+	for _, tc := range []struct {
+		mode     ParticipationMode
+		expected []bool
+	}{
+		{ModeBondedValidators, []bool{true, false, false}},
+		{ModeValidators, []bool{true, true, false}},
+		{ModeAnyAccount, []bool{true, true, true}},
+		{ModeAllowlist, []bool{false, true, false}},
+		{ParticipationMode("unknown"), []bool{false, false, false}},
+	} {
+		setParams(ctx, keeper, func(params *Params) {
+			params.ProposerMode = tc.mode
+			params.VoterMode = tc.mode
+			params.ProposerAllowlist = []sdk.AccAddress{unbonded}
+			params.VoterAllowlist = []sdk.AccAddress{unbonded}
+		})
+		for i, addr := range []sdk.AccAddress{bonded, unbonded, account} {
+			require.Equal(t, tc.expected[i], keeper.CanSubmitProposal(ctx, addr), "mode %s, address %d", tc.mode, i)
+			require.Equal(t, tc.expected[i], keeper.CanVote(ctx, addr), "mode %s, address %d", tc.mode, i)
+		}
+	}
+}
+
+func TestProposerAndVoterModesAreSeparate(t *testing.T) {
+	input, keeper, _ := createTestInput(t)
+	ctx := input.Ctx
+	input.CreateValidator(t, 0, 100)
+	setParams(ctx, keeper, func(params *Params) {
+		params.ProposerMode = ModeAnyAccount
+		params.VoterMode = ModeAllowlist
+		params.VoterAllowlist = []sdk.AccAddress{addrs[1]}
+	})
+	require.True(t, keeper.CanSubmitProposal(ctx, addrs[2]))
+	require.False(t, keeper.CanVote(ctx, addrs[2]))
+	require.True(t, keeper.CanVote(ctx, addrs[1]))
+	require.False(t, keeper.CanVote(ctx, addrs[0]))
+}
+
+func TestParamsValidate(t *testing.T) {
+	require.NoError(t, DefaultParams().Validate(gov.DefaultPeriod))
+
+	params := DefaultParams()
+	params.ProposerMode = ParticipationMode("unknown")
+	require.Error(t, params.Validate(gov.DefaultPeriod))
+
+	params = DefaultParams()
+	params.VoterAllowlist = []sdk.AccAddress{nil}
+	require.Error(t, params.Validate(gov.DefaultPeriod))
+
+	params = DefaultParams()
+	params.EmergencyVotingPeriod = gov.DefaultPeriod
+	require.Error(t, params.Validate(gov.DefaultPeriod))
+	require.NoError(t, params.Validate(2*gov.DefaultPeriod))
+
+	params = DefaultParams()
+	params.ProposalTypeDenylist = []ProposalType{NewProposalType(gov.RouterKey, "")}
+	require.Error(t, params.Validate(gov.DefaultPeriod))
+}
+
+// isBondedValidatorByIteration is the lookup replaced by isBondedValidator, scanning the bonded validators by power
+func isBondedValidatorByIteration(keeper Keeper, ctx sdk.Context, addr sdk.AccAddress) bool {
+	isValidator := false
+	keeper.stakingKeeper.IterateBondedValidatorsByPower(ctx, func(index int64, validator exported.ValidatorI) (stop bool) {
+		if validator.GetOperator().Equals(addr) {
+			isValidator = true
+			return true
+		}
+		return false
+	})
+	return isValidator
+}
+
+// seedBondedValidators stores n bonded validators directly, since going through the staking handler and EndBlocker for
+// each of them takes quadratic time. Returns the operator of the validator with the least power.
+func seedBondedValidators(input *testInput, n int) sdk.AccAddress {
+	ctx := input.Ctx
+	stakingParams := input.StakingKeeper.GetParams(ctx)
+	stakingParams.MaxValidators = uint16(n)
+	input.StakingKeeper.SetParams(ctx, stakingParams)
+	var weakest sdk.AccAddress
+	for i := 0; i < n; i++ {
+		pubKey := ed25519.GenPrivKeyFromSecret([]byte(fmt.Sprintf("bench-validator-%d", i))).PubKey()
+		valAddr := sdk.ValAddress(pubKey.Address())
+		validator := staking.NewValidator(valAddr, pubKey, staking.Description{Moniker: fmt.Sprintf("validator-%d", i)})
+		validator.Status = sdk.Bonded
+		validator.Tokens = sdk.TokensFromConsensusPower(int64(n - i))
+		validator.DelegatorShares = validator.Tokens.ToDec()
+		input.StakingKeeper.SetValidator(ctx, validator)
+		input.StakingKeeper.SetValidatorByPowerIndex(ctx, validator)
+		weakest = sdk.AccAddress(valAddr)
+	}
+	return weakest
+}
+
+func BenchmarkIsBondedValidator(b *testing.B) {
+	for _, n := range []int{100, 1000, 3000} {
+		input, keeper, _ := createTestInput(b)
+		weakest := seedBondedValidators(input, n)
+		ctx := input.Ctx
+		if !keeper.isBondedValidator(ctx, weakest) || !isBondedValidatorByIteration(keeper, ctx, weakest) {
+			b.Fatal("seeded validator is not bonded")
+		}
+		for _, lookup := range []struct {
+			name string
+			fn   func(keeper Keeper, ctx sdk.Context, addr sdk.AccAddress) bool
+		}{
+			{"Keyed", Keeper.isBondedValidator},
+			{"Iteration", isBondedValidatorByIteration},
+		} {
+			// the weakest validator and non-validator accounts are the worst cases of the iteration
+			for _, target := range []struct {
+				name string
+				addr sdk.AccAddress
+			}{
+				{"WeakestValidator", weakest},
+				{"NonValidator", addrs[0]},
+			} {
+				b.Run(fmt.Sprintf("%s/%d/%s", lookup.name, n, target.name), func(b *testing.B) {
+					for i := 0; i < b.N; i++ {
+						lookup.fn(keeper, ctx, target.addr)
+					}
+				})
+			}
+		}
+	}
+}
+
+func TestIsProposalTypeAllowed(t *testing.T) {
+	input, keeper, _ := createTestInput(t)
+	ctx := input.Ctx
+	text := NewProposalType(gov.RouterKey, gov.ProposalTypeText)
+	paramChange := NewProposalType("params", "ParameterChange")
+
+	for _, tc := range []struct {
+		name      string
+		allowlist []ProposalType
+		denylist  []ProposalType
+		expected  []bool
+	}{
+		{"empty lists allow all", nil, nil, []bool{true, true}},
+		{"allowlist", []ProposalType{text}, nil, []bool{true, false}},
+		{"denylist", nil, []ProposalType{text}, []bool{false, true}},
+		{"denylist overrides allowlist", []ProposalType{text, paramChange}, []ProposalType{text}, []bool{false, true}},
+	} {
+		setParams(ctx, keeper, func(params *Params) {
+			params.ProposalTypeAllowlist = tc.allowlist
+			params.ProposalTypeDenylist = tc.denylist
+		})
+		for i, proposalType := range []ProposalType{text, paramChange} {
+			require.Equal(t, tc.expected[i], keeper.IsProposalTypeAllowed(ctx, proposalType), "%s: %s", tc.name, proposalType)
+		}
+	}
+}
@@ -1,60 +1,121 @@
 package gov
 
 import (
-	"fmt"
+	"encoding/json"
 
+	"github.com/gorilla/mux"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/cosmos/cosmos-sdk/x/gov"
-	"github.com/cosmos/cosmos-sdk/x/staking/exported"
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/likecoin/likechain/x/gov/client/cli"
+	"github.com/likecoin/likechain/x/gov/client/rest"
 )
 
 var (
-	_ module.AppModule = AppModule{}
+	_ module.AppModuleBasic = AppModuleBasic{}
+	_ module.AppModule      = AppModule{}
 )
 
+type AppModuleBasic struct {
+	gov.AppModuleBasic
+}
+
+func NewAppModuleBasic(proposalHandlers ...govclient.ProposalHandler) AppModuleBasic {
+	return AppModuleBasic{
+		AppModuleBasic: gov.NewAppModuleBasic(proposalHandlers...),
+	}
+}
+
+func (b AppModuleBasic) RegisterCodec(cdc *codec.Codec) {
+	b.AppModuleBasic.RegisterCodec(cdc)
+	RegisterCodec(cdc)
+}
+
+func (b AppModuleBasic) DefaultGenesis() json.RawMessage {
+	return mustMergeGenesis(b.AppModuleBasic.DefaultGenesis(), DefaultGenesisState())
+}
+
+func (b AppModuleBasic) ValidateGenesis(bz json.RawMessage) error {
+	err := b.AppModuleBasic.ValidateGenesis(bz)
+	if err != nil {
+		return err
+	}
+	var govData gov.GenesisState
+	err = gov.ModuleCdc.UnmarshalJSON(bz, &govData)
+	if err != nil {
+		return err
+	}
+	data, err := unmarshalGenesis(bz)
+	if err != nil {
+		return err
+	}
+	return ValidateGenesis(data, govData)
+}
+
+func (b AppModuleBasic) RegisterRESTRoutes(ctx context.CLIContext, rtr *mux.Router) {
+	b.AppModuleBasic.RegisterRESTRoutes(ctx, rtr)
+	rest.RegisterRoutes(ctx, rtr)
+}
+
+func (b AppModuleBasic) GetTxCmd(cdc *codec.Codec) *cobra.Command {
+	txCmd := b.AppModuleBasic.GetTxCmd(cdc)
+	txCmd.AddCommand(cli.GetTxCmds(cdc)...)
+	return txCmd
+}
+
+func (b AppModuleBasic) GetQueryCmd(cdc *codec.Codec) *cobra.Command {
+	queryCmd := b.AppModuleBasic.GetQueryCmd(cdc)
+	queryCmd.AddCommand(cli.GetQueryCmds(gov.QuerierRoute, cdc)...)
+	return queryCmd
+}
+
 type AppModule struct {
 	gov.AppModule
-	stakingKeeper gov.StakingKeeper
+	keeper Keeper
 }
 
-func NewAppModule(keeper gov.Keeper, supplyKeeper gov.SupplyKeeper, stakingKeeper gov.StakingKeeper) AppModule {
+func NewAppModule(govKeeper gov.Keeper, supplyKeeper gov.SupplyKeeper, keeper Keeper) AppModule {
 	return AppModule{
-		AppModule:     gov.NewAppModule(keeper, supplyKeeper),
-		stakingKeeper: stakingKeeper,
+		AppModule: gov.NewAppModule(govKeeper, supplyKeeper),
+		keeper:    keeper,
 	}
 }
 
-func checkIsValidator(ctx sdk.Context, stakingKeeper gov.StakingKeeper, addr sdk.Address) bool {
-	isValidator := false
-	stakingKeeper.IterateBondedValidatorsByPower(ctx, func(index int64, validator exported.ValidatorI) (stop bool) {
-		if validator.GetOperator().Equals(addr) {
-			isValidator = true
-			return true
-		}
-		return false
-	})
-	return isValidator
-}
-
-// proxy handler which intercepts MsgSubmitProposal
+// proxy handler which intercepts MsgSubmitProposal and MsgVote, and handles the wrapper messages
 func (am AppModule) NewHandler() sdk.Handler {
 	govHandler := am.AppModule.NewHandler()
-	wrappedHandler := func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
-		ctx = ctx.WithEventManager(sdk.NewEventManager())
-		switch msg := msg.(type) {
-		case gov.MsgSubmitProposal:
-			if !checkIsValidator(ctx, am.stakingKeeper, msg.Proposer) {
-				errMsg := fmt.Sprintf("only validators can submit proposals")
-				return sdk.ErrInvalidAddress(errMsg).Result()
-			}
-		case gov.MsgVote:
-			if !checkIsValidator(ctx, am.stakingKeeper, msg.Voter) {
-				errMsg := fmt.Sprintf("only validators can vote")
-				return sdk.ErrInvalidAddress(errMsg).Result()
-			}
-		}
-		return govHandler(ctx, msg)
-	}
+	wrappedHandler := WrapGovHandler(am.keeper, govHandler)
 	return wrappedHandler
 }
+
+func (am AppModule) NewQuerierHandler() sdk.Querier {
+	return NewQuerier(am.keeper, am.AppModule.NewQuerierHandler())
+}
+
+func (am AppModule) InitGenesis(ctx sdk.Context, data json.RawMessage) []abci.ValidatorUpdate {
+	validatorUpdates := am.AppModule.InitGenesis(ctx, data)
+	genesisState, err := unmarshalGenesis(data)
+	if err != nil {
+		panic(err)
+	}
+	InitGenesis(ctx, am.keeper, genesisState)
+	return validatorUpdates
+}
+
+func (am AppModule) ExportGenesis(ctx sdk.Context) json.RawMessage {
+	gs := ExportGenesis(ctx, am.keeper)
+	return mustMergeGenesis(am.AppModule.ExportGenesis(ctx), gs)
+}
+
+// tallies the proposals with the wrapper rules before handing over to the x/gov EndBlocker
+func (am AppModule) EndBlock(ctx sdk.Context, req abci.RequestEndBlock) []abci.ValidatorUpdate {
+	EndBlocker(ctx, am.keeper)
+	return am.AppModule.EndBlock(ctx, req)
+}
@@ -0,0 +1,149 @@
+package gov
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k Keeper) GetProposalSubmission(ctx sdk.Context, proposalID uint64) (submission ProposalSubmission, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	proposer := store.Get(ProposalProposerKey(proposalID))
+	if proposer == nil {
+		return submission, false
+	}
+	bz := store.Get(ProposalSubmissionKey(proposer, proposalID))
+	if bz == nil {
+		return submission, false
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &submission)
+	return submission, true
+}
+
+func (k Keeper) SetProposalSubmission(ctx sdk.Context, submission ProposalSubmission) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(ProposalSubmissionKey(submission.Proposer, submission.ProposalID), k.cdc.MustMarshalBinaryLengthPrefixed(submission))
+	store.Set(ProposalProposerKey(submission.ProposalID), submission.Proposer.Bytes())
+}
+
+func (k Keeper) DeleteProposalSubmission(ctx sdk.Context, submission ProposalSubmission) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(ProposalSubmissionKey(submission.Proposer, submission.ProposalID))
+	store.Delete(ProposalProposerKey(submission.ProposalID))
+}
+
+// IterateProposerSubmissions iterates over the proposal submissions of the proposer
+func (k Keeper) IterateProposerSubmissions(ctx sdk.Context, proposer sdk.AccAddress, cb func(submission ProposalSubmission) (stop bool)) {
+	k.iterateProposalSubmissions(ctx, ProposerSubmissionsPrefix(proposer), cb)
+}
+
+// IterateProposalSubmissions iterates over the proposal submissions of all proposers
+func (k Keeper) IterateProposalSubmissions(ctx sdk.Context, cb func(submission ProposalSubmission) (stop bool)) {
+	k.iterateProposalSubmissions(ctx, ProposalSubmissionKeyPrefix, cb)
+}
+
+func (k Keeper) iterateProposalSubmissions(ctx sdk.Context, prefix []byte, cb func(submission ProposalSubmission) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), prefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var submission ProposalSubmission
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &submission)
+		if cb(submission) {
+			break
+		}
+	}
+}
+
+func (k Keeper) GetProposalSubmissions(ctx sdk.Context) (submissions []ProposalSubmission) {
+	k.IterateProposalSubmissions(ctx, func(submission ProposalSubmission) bool {
+		submissions = append(submissions, submission)
+		return false
+	})
+	return submissions
+}
+
+// deactivateProposalSubmission marks the submission as inactive when the proposal is tallied or dropped, so that it
+// only counts in the proposal rate window
+func (k Keeper) deactivateProposalSubmission(ctx sdk.Context, proposalID uint64) {
+	submission, found := k.GetProposalSubmission(ctx, proposalID)
+	if !found || !submission.Active {
+		return
+	}
+	submission.Active = false
+	k.SetProposalSubmission(ctx, submission)
+}
+
+func isInWindow(submission ProposalSubmission, now time.Time, window time.Duration) bool {
+	return submission.SubmitTime.Add(window).After(now)
+}
+
+// GetProposerQuota counts the proposals submitted by the proposer against the limits in the params
+func (k Keeper) GetProposerQuota(ctx sdk.Context, proposer sdk.AccAddress) ProposerQuota {
+	now := ctx.BlockHeader().Time
+	window := k.ProposalRateWindow(ctx)
+	quota := ProposerQuota{Proposer: proposer}
+	k.IterateProposerSubmissions(ctx, proposer, func(submission ProposalSubmission) bool {
+		if submission.Active {
+			quota.ActiveProposals++
+		}
+		if isInWindow(submission, now, window) {
+			quota.ProposalsInWindow++
+		}
+		return false
+	})
+
+	maxActive := k.MaxActiveProposals(ctx)
+	maxInWindow := k.MaxProposalsPerWindow(ctx)
+	quota.Unlimited = maxActive == 0 && maxInWindow == 0
+	remaining := func(max, count uint64) uint64 {
+		if count >= max {
+			return 0
+		}
+		return max - count
+	}
+	switch {
+	case quota.Unlimited:
+	case maxActive == 0:
+		quota.RemainingProposals = remaining(maxInWindow, quota.ProposalsInWindow)
+	case maxInWindow == 0:
+		quota.RemainingProposals = remaining(maxActive, quota.ActiveProposals)
+	default:
+		quota.RemainingProposals = remaining(maxActive, quota.ActiveProposals)
+		if r := remaining(maxInWindow, quota.ProposalsInWindow); r < quota.RemainingProposals {
+			quota.RemainingProposals = r
+		}
+	}
+	return quota
+}
+
+// checkProposerQuota returns an error if the proposer has reached any of the proposal submission limits
+func (k Keeper) checkProposerQuota(ctx sdk.Context, proposer sdk.AccAddress) sdk.Error {
+	quota := k.GetProposerQuota(ctx, proposer)
+	maxActive := k.MaxActiveProposals(ctx)
+	if maxActive > 0 && quota.ActiveProposals >= maxActive {
+		return ErrTooManyActiveProposals(k.Codespace(), maxActive)
+	}
+	maxInWindow := k.MaxProposalsPerWindow(ctx)
+	if maxInWindow > 0 && quota.ProposalsInWindow >= maxInWindow {
+		return ErrTooManyProposalsInWindow(k.Codespace(), maxInWindow, k.ProposalRateWindow(ctx))
+	}
+	return nil
+}
+
+// recordProposalSubmission records the submitted proposal, and prunes the inactive submissions of the proposer which
+// have left the proposal rate window
+func (k Keeper) recordProposalSubmission(ctx sdk.Context, proposer sdk.AccAddress, proposalID uint64) {
+	now := ctx.BlockHeader().Time
+	window := k.ProposalRateWindow(ctx)
+	var expired []ProposalSubmission
+	k.IterateProposerSubmissions(ctx, proposer, func(submission ProposalSubmission) bool {
+		if !submission.Active && !isInWindow(submission, now, window) {
+			expired = append(expired, submission)
+		}
+		return false
+	})
+	for _, submission := range expired {
+		k.DeleteProposalSubmission(ctx, submission)
+	}
+	k.SetProposalSubmission(ctx, NewProposalSubmission(proposalID, proposer, now))
+}
@@ -0,0 +1,78 @@
+package gov
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+
+	"github.com/likecoin/likechain/x/gov/types"
+)
+
+func TestProposerQuotaUnlimitedByDefault(t *testing.T) {
+	input, keeper, handler := createTestInput(t)
+	ctx := input.Ctx
+	validator := sdk.AccAddress(input.CreateValidator(t, 0, 100))
+	for i := 0; i < 10; i++ {
+		submitTextProposal(t, ctx, input, handler, validator)
+	}
+	quota := keeper.GetProposerQuota(ctx, validator)
+	require.True(t, quota.Unlimited)
+	require.Equal(t, uint64(10), quota.ActiveProposals)
+	require.Equal(t, uint64(10), quota.ProposalsInWindow)
+}
+
+func TestMaxActiveProposals(t *testing.T) {
+	input, keeper, handler := createTestInput(t)
+	ctx := input.Ctx
+	validator := sdk.AccAddress(input.CreateValidator(t, 0, 100))
+	setParams(ctx, keeper, func(params *Params) {
+		params.MaxActiveProposals = 2
+	})
+	submitTextProposal(t, ctx, input, handler, validator)
+	submitTextProposal(t, ctx, input, handler, validator)
+	require.Equal(t, uint64(0), keeper.GetProposerQuota(ctx, validator).RemainingProposals)
+
+	result := handler(ctx, gov.NewMsgSubmitProposal(textProposal(), minDeposit(ctx, input), validator))
+	require.Equal(t, types.CodeProposalRateLimited, result.Code)
+	result = handler(ctx, NewMsgSubmitEmergencyProposal(textProposal(), minDeposit(ctx, input), validator))
+	require.Equal(t, types.CodeProposalRateLimited, result.Code)
+	// other proposers have their own quotas
+	submitTextProposal(t, ctx, input, handler, sdk.AccAddress(input.CreateValidator(t, 1, 10)))
+
+	// tallied proposals are no longer active
+	ctx = input.NextBlock(votingPeriod(ctx, input))
+	EndBlocker(ctx, keeper)
+	quota := keeper.GetProposerQuota(ctx, validator)
+	require.Equal(t, uint64(0), quota.ActiveProposals)
+	require.Equal(t, uint64(2), quota.RemainingProposals)
+	submitTextProposal(t, ctx, input, handler, validator)
+}
+
+func TestMaxProposalsPerWindow(t *testing.T) {
+	input, keeper, handler := createTestInput(t)
+	ctx := input.Ctx
+	validator := sdk.AccAddress(input.CreateValidator(t, 0, 100))
+	window := 3 * votingPeriod(ctx, input)
+	setParams(ctx, keeper, func(params *Params) {
+		params.MaxProposalsPerWindow = 2
+		params.ProposalRateWindow = window
+	})
+	submitTextProposal(t, ctx, input, handler, validator)
+	submitTextProposal(t, ctx, input, handler, validator)
+
+	// tallied proposals still count in the window
+	ctx = input.NextBlock(votingPeriod(ctx, input))
+	EndBlocker(ctx, keeper)
+	result := handler(ctx, gov.NewMsgSubmitProposal(textProposal(), minDeposit(ctx, input), validator))
+	require.Equal(t, types.CodeProposalRateLimited, result.Code)
+
+	ctx = input.NextBlock(window - votingPeriod(ctx, input) + time.Second)
+	require.Equal(t, uint64(2), keeper.GetProposerQuota(ctx, validator).RemainingProposals)
+	submitTextProposal(t, ctx, input, handler, validator)
+	// the inactive submissions out of the window are pruned on the next submission
+	require.Len(t, keeper.GetProposalSubmissions(ctx), 1)
+}
@@ -0,0 +1,180 @@
+package gov
+
+import (
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+	"github.com/cosmos/cosmos-sdk/x/staking/exported"
+)
+
+// NewQuerier handles the wrapper specific query endpoints, and passes the others to the original gov querier
+func NewQuerier(k Keeper, govQuerier sdk.Querier) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) (res []byte, err sdk.Error) {
+		switch path[0] {
+		case QueryWrapperParams:
+			return queryWrapperParams(ctx, req, k)
+		case QueryAuthorizedVoter:
+			return queryAuthorizedVoter(ctx, req, k)
+		case QueryVoterValidator:
+			return queryVoterValidator(ctx, req, k)
+		case QueryEmergencyProposals:
+			return queryEmergencyProposals(ctx, req, k)
+		case QueryTallyResults:
+			return queryTallyResults(ctx, req, k)
+		case QueryProposerQuota:
+			return queryProposerQuota(ctx, req, k)
+		case QueryProposerQuotas:
+			return queryProposerQuotas(ctx, req, k)
+		case gov.QueryTally:
+			return queryTally(ctx, path, req, k, govQuerier)
+		default:
+			return govQuerier(ctx, path, req)
+		}
+	}
+}
+
+func queryWrapperParams(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	params := k.GetParams(ctx)
+
+	res, err := codec.MarshalJSONIndent(ModuleCdc, params)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to JSON marshal result: %s", err.Error()))
+	}
+
+	return res, nil
+}
+
+func queryAuthorizedVoter(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params QueryAuthorizedVoterParams
+	err := ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	voter, found := k.GetAuthorizedVoter(ctx, params.ValidatorAddress)
+	if !found {
+		return nil, ErrVoterNotFound(k.Codespace())
+	}
+
+	res, err := codec.MarshalJSONIndent(ModuleCdc, NewVoterAuthorization(params.ValidatorAddress, voter))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to JSON marshal result: %s", err.Error()))
+	}
+
+	return res, nil
+}
+
+func queryVoterValidator(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params QueryVoterValidatorParams
+	err := ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	valAddr, found := k.GetVoterValidator(ctx, params.Voter)
+	if !found {
+		return nil, ErrVoterNotFound(k.Codespace())
+	}
+
+	res, err := codec.MarshalJSONIndent(ModuleCdc, NewVoterAuthorization(valAddr, params.Voter))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to JSON marshal result: %s", err.Error()))
+	}
+
+	return res, nil
+}
+
+func queryEmergencyProposals(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	proposalIDs := k.GetEmergencyProposals(ctx)
+	if proposalIDs == nil {
+		proposalIDs = []uint64{}
+	}
+
+	res, err := codec.MarshalJSONIndent(ModuleCdc, proposalIDs)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to JSON marshal result: %s", err.Error()))
+	}
+
+	return res, nil
+}
+
+func queryTallyResults(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params gov.QueryProposalParams
+	err := ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	proposal, found := k.govKeeper.GetProposal(ctx, params.ProposalID)
+	if !found {
+		return nil, ErrUnknownProposal(k.Codespace(), params.ProposalID)
+	}
+	// the votes are deleted after tally, so only the final result in the applied mode is available
+	if proposal.Status != gov.StatusDepositPeriod && proposal.Status != gov.StatusVotingPeriod {
+		return nil, ErrProposalTallied(k.Codespace(), params.ProposalID)
+	}
+
+	res, err := codec.MarshalJSONIndent(ModuleCdc, k.GetTallyResults(ctx, params.ProposalID))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to JSON marshal result: %s", err.Error()))
+	}
+
+	return res, nil
+}
+
+// queryTally replaces the tally of the gov querier for proposals in voting period, since the wrapper tallies them with
+// its own rules. The other proposals are left to the gov querier, which returns their final tally results.
+func queryTally(ctx sdk.Context, path []string, req abci.RequestQuery, k Keeper, govQuerier sdk.Querier) ([]byte,
+	sdk.Error) {
+	var params gov.QueryProposalParams
+	err := ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	proposal, found := k.govKeeper.GetProposal(ctx, params.ProposalID)
+	if !found || proposal.Status != gov.StatusVotingPeriod {
+		return govQuerier(ctx, path, req)
+	}
+	_, _, tallyResult := k.Tally(ctx, proposal)
+
+	res, err := codec.MarshalJSONIndent(ModuleCdc, tallyResult)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to JSON marshal result: %s", err.Error()))
+	}
+
+	return res, nil
+}
+
+func queryProposerQuota(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params QueryProposerQuotaParams
+	err := ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	res, err := codec.MarshalJSONIndent(ModuleCdc, k.GetProposerQuota(ctx, params.Proposer))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to JSON marshal result: %s", err.Error()))
+	}
+
+	return res, nil
+}
+
+// queryProposerQuotas returns the quotas of the operator accounts of all validators
+func queryProposerQuotas(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	quotas := ProposerQuotas{}
+	k.stakingKeeper.IterateValidators(ctx, func(index int64, validator exported.ValidatorI) bool {
+		quotas = append(quotas, k.GetProposerQuota(ctx, sdk.AccAddress(validator.GetOperator())))
+		return false
+	})
+
+	res, err := codec.MarshalJSONIndent(ModuleCdc, quotas)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to JSON marshal result: %s", err.Error()))
+	}
+
+	return res, nil
+}
@@ -0,0 +1,227 @@
+package gov
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+	"github.com/cosmos/cosmos-sdk/x/staking/exported"
+)
+
+// The tally logic is ported from x/gov, which does not expose it, so that the wrapper can apply its own rules.
+
+// validatorGovInfo used for tallying
+type validatorGovInfo struct {
+	Address             sdk.ValAddress // address of the validator operator
+	BondedTokens        sdk.Int        // Power of a Validator
+	DelegatorShares     sdk.Dec        // Total outstanding delegator shares
+	DelegatorDeductions sdk.Dec        // Delegator deductions from validator's delegators voting independently
+	Vote                gov.VoteOption // Vote of the validator
+}
+
+func newTallyMap() map[gov.VoteOption]sdk.Dec {
+	results := make(map[gov.VoteOption]sdk.Dec)
+	results[gov.OptionYes] = sdk.ZeroDec()
+	results[gov.OptionAbstain] = sdk.ZeroDec()
+	results[gov.OptionNo] = sdk.ZeroDec()
+	results[gov.OptionNoWithVeto] = sdk.ZeroDec()
+	return results
+}
+
+// tallyVotes computes the voting power of each option, without deleting the votes.
+// The bonded tokens of each validator are capped at the fraction of the total bonded tokens given by powerCap unless
+// it is zero, so the returned total bonded tokens is the sum of the capped bonded tokens. A cap too small to leave any
+// bonded tokens to a validator would fail every proposal, so the tokens are left uncapped in that case.
+func (k Keeper) tallyVotes(ctx sdk.Context, proposalID uint64, powerCap sdk.Dec) (
+	results map[gov.VoteOption]sdk.Dec, totalVotingPower sdk.Dec, totalBondedTokens sdk.Int) {
+	results = newTallyMap()
+	totalVotingPower = sdk.ZeroDec()
+	totalBondedTokens = sdk.ZeroInt()
+	currValidators := make(map[string]validatorGovInfo)
+
+	var maxBondedTokens sdk.Int
+	hasCap := powerCap.IsPositive()
+	if hasCap {
+		maxBondedTokens = powerCap.MulInt(k.stakingKeeper.TotalBondedTokens(ctx)).TruncateInt()
+		if !maxBondedTokens.IsPositive() {
+			k.Logger(ctx).Error(fmt.Sprintf("validator power cap %s leaves no voting power, tallying without cap", powerCap))
+			hasCap = false
+		}
+	}
+
+	// fetch all the bonded validators, insert them into currValidators
+	k.stakingKeeper.IterateBondedValidatorsByPower(ctx, func(index int64, validator exported.ValidatorI) (stop bool) {
+		bondedTokens := validator.GetBondedTokens()
+		if hasCap {
+			bondedTokens = sdk.MinInt(bondedTokens, maxBondedTokens)
+		}
+		totalBondedTokens = totalBondedTokens.Add(bondedTokens)
+		currValidators[validator.GetOperator().String()] = validatorGovInfo{
+			Address:             validator.GetOperator(),
+			BondedTokens:        bondedTokens,
+			DelegatorShares:     validator.GetDelegatorShares(),
+			DelegatorDeductions: sdk.ZeroDec(),
+			Vote:                gov.OptionEmpty,
+		}
+		return false
+	})
+
+	k.govKeeper.IterateVotes(ctx, proposalID, func(vote gov.Vote) bool {
+		// if validator, just record it in the map
+		// if delegator tally voting power
+		valAddrStr := sdk.ValAddress(vote.Voter).String()
+		if val, ok := currValidators[valAddrStr]; ok {
+			val.Vote = vote.Option
+			currValidators[valAddrStr] = val
+		} else {
+			// iterate over all delegations from voter, deduct from any delegated-to validators
+			k.stakingKeeper.IterateDelegations(ctx, vote.Voter, func(index int64, delegation exported.DelegationI) (stop bool) {
+				valAddrStr := delegation.GetValidatorAddr().String()
+
+				if val, ok := currValidators[valAddrStr]; ok {
+					val.DelegatorDeductions = val.DelegatorDeductions.Add(delegation.GetShares())
+					currValidators[valAddrStr] = val
+
+					delegatorShare := delegation.GetShares().Quo(val.DelegatorShares)
+					votingPower := delegatorShare.MulInt(val.BondedTokens)
+
+					results[vote.Option] = results[vote.Option].Add(votingPower)
+					totalVotingPower = totalVotingPower.Add(votingPower)
+				}
+
+				return false
+			})
+		}
+		return false
+	})
+
+	// iterate over the validators again to tally their voting power
+	for _, val := range currValidators {
+		if val.Vote == gov.OptionEmpty {
+			continue
+		}
+
+		sharesAfterDeductions := val.DelegatorShares.Sub(val.DelegatorDeductions)
+		fractionAfterDeductions := sharesAfterDeductions.Quo(val.DelegatorShares)
+		votingPower := fractionAfterDeductions.MulInt(val.BondedTokens)
+
+		results[val.Vote] = results[val.Vote].Add(votingPower)
+		totalVotingPower = totalVotingPower.Add(votingPower)
+	}
+
+	return results, totalVotingPower, totalBondedTokens
+}
+
+// tallyValidatorVotes counts each bonded validator as one vote, ignoring the votes of delegators.
+// The returned total is the number of bonded validators, which replaces the total bonded tokens in the tally rules.
+func (k Keeper) tallyValidatorVotes(ctx sdk.Context, proposalID uint64) (
+	results map[gov.VoteOption]sdk.Dec, totalVotingPower sdk.Dec, totalValidators sdk.Int) {
+	results = newTallyMap()
+	totalVotingPower = sdk.ZeroDec()
+	bondedValidators := make(map[string]bool)
+
+	k.stakingKeeper.IterateBondedValidatorsByPower(ctx, func(index int64, validator exported.ValidatorI) (stop bool) {
+		bondedValidators[validator.GetOperator().String()] = true
+		return false
+	})
+
+	k.govKeeper.IterateVotes(ctx, proposalID, func(vote gov.Vote) bool {
+		if bondedValidators[sdk.ValAddress(vote.Voter).String()] {
+			results[vote.Option] = results[vote.Option].Add(sdk.OneDec())
+			totalVotingPower = totalVotingPower.Add(sdk.OneDec())
+		}
+		return false
+	})
+
+	return results, totalVotingPower, sdk.NewInt(int64(len(bondedValidators)))
+}
+
+// tallyVotesInMode tallies the votes in the given mode, falling back to stake weighted tally for unknown modes
+func (k Keeper) tallyVotesInMode(ctx sdk.Context, proposalID uint64, mode TallyMode) (
+	results map[gov.VoteOption]sdk.Dec, totalVotingPower sdk.Dec, totalPower sdk.Int) {
+	if mode == TallyModeOneValidatorOneVote {
+		return k.tallyValidatorVotes(ctx, proposalID)
+	}
+	return k.tallyVotes(ctx, proposalID, k.ValidatorPowerCap(ctx))
+}
+
+// deleteVotes removes the votes of a tallied proposal, as x/gov does after tallying
+func (k Keeper) deleteVotes(ctx sdk.Context, proposalID uint64) {
+	store := ctx.KVStore(k.govStoreKey)
+	k.govKeeper.IterateVotes(ctx, proposalID, func(vote gov.Vote) bool {
+		store.Delete(gov.VoteKey(proposalID, vote.Voter))
+		return false
+	})
+}
+
+// checkTally applies the tally params on the results, following the rules of x/gov
+func checkTally(results map[gov.VoteOption]sdk.Dec, totalVotingPower sdk.Dec, totalBondedTokens sdk.Int,
+	tallyParams gov.TallyParams) (passes bool, burnDeposits bool) {
+	// If there is no staked coins, the proposal fails
+	if totalBondedTokens.IsZero() {
+		return false, false
+	}
+
+	// If there is not enough quorum of votes, the proposal fails
+	percentVoting := totalVotingPower.Quo(totalBondedTokens.ToDec())
+	if percentVoting.LT(tallyParams.Quorum) {
+		return false, true
+	}
+
+	// If no one votes (everyone abstains), proposal fails
+	if totalVotingPower.Sub(results[gov.OptionAbstain]).Equal(sdk.ZeroDec()) {
+		return false, false
+	}
+
+	// If more than 1/3 of voters veto, proposal fails
+	if results[gov.OptionNoWithVeto].Quo(totalVotingPower).GT(tallyParams.Veto) {
+		return false, true
+	}
+
+	// If more than 1/2 of non-abstaining voters vote Yes, proposal passes
+	if results[gov.OptionYes].Quo(totalVotingPower.Sub(results[gov.OptionAbstain])).GT(tallyParams.Threshold) {
+		return true, false
+	}
+
+	// If more than 1/2 of non-abstaining voters vote No, proposal fails
+	return false, false
+}
+
+// checkEmergencyTally requires the yes votes of an emergency proposal to reach the emergency threshold of the total
+// bonded voting power, instead of the threshold of the voting power voted. Deposits are burnt following x/gov rules.
+func checkEmergencyTally(results map[gov.VoteOption]sdk.Dec, totalVotingPower sdk.Dec, totalBondedTokens sdk.Int,
+	tallyParams gov.TallyParams, emergencyThreshold sdk.Dec) (passes bool, burnDeposits bool) {
+	if totalBondedTokens.IsZero() {
+		return false, false
+	}
+	_, burnDeposits = checkTally(results, totalVotingPower, totalBondedTokens, tallyParams)
+	if burnDeposits {
+		return false, true
+	}
+	passes = results[gov.OptionYes].Quo(totalBondedTokens.ToDec()).GTE(emergencyThreshold)
+	return passes, false
+}
+
+// Tally tallies the votes of the proposal in the tally mode of the params, without deleting them
+func (k Keeper) Tally(ctx sdk.Context, proposal gov.Proposal) (passes bool, burnDeposits bool, tallyResults gov.TallyResult) {
+	results, totalVotingPower, totalPower := k.tallyVotesInMode(ctx, proposal.ProposalID, k.TallyMode(ctx))
+	tallyResults = gov.NewTallyResultFromMap(results)
+	tallyParams := k.TallyParams(ctx, NewProposalType(proposal.ProposalRoute(), proposal.ProposalType()))
+
+	if k.IsEmergencyProposal(ctx, proposal.ProposalID) {
+		passes, burnDeposits = checkEmergencyTally(results, totalVotingPower, totalPower, tallyParams, k.EmergencyThreshold(ctx))
+		return passes, burnDeposits, tallyResults
+	}
+	passes, burnDeposits = checkTally(results, totalVotingPower, totalPower, tallyParams)
+	return passes, burnDeposits, tallyResults
+}
+
+// GetTallyResults returns the current tally of the proposal in both tally modes
+func (k Keeper) GetTallyResults(ctx sdk.Context, proposalID uint64) TallyResults {
+	stakeWeighted, _, _ := k.tallyVotesInMode(ctx, proposalID, TallyModeStakeWeighted)
+	oneValidatorOneVote, _, _ := k.tallyVotesInMode(ctx, proposalID, TallyModeOneValidatorOneVote)
+	return NewTallyResults(
+		proposalID, k.TallyMode(ctx),
+		gov.NewTallyResultFromMap(stakeWeighted), gov.NewTallyResultFromMap(oneValidatorOneVote),
+	)
+}
@@ -0,0 +1,171 @@
+package gov
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+)
+
+func TestTallyParamsByProposalType(t *testing.T) {
+	input, keeper, _ := createTestInput(t)
+	ctx := input.Ctx
+	text := NewProposalType(gov.RouterKey, gov.ProposalTypeText)
+	other := NewProposalType("params", "ParameterChange")
+	strict := gov.NewTallyParams(sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(8, 1), sdk.NewDecWithPrec(334, 3))
+
+	require.Equal(t, input.GovKeeper.GetTallyParams(ctx), keeper.TallyParams(ctx, text))
+	setParams(ctx, keeper, func(params *Params) {
+		params.ProposalTallyParams = []ProposalTallyParams{NewProposalTallyParams(text, strict)}
+	})
+	require.Equal(t, strict, keeper.TallyParams(ctx, text))
+	require.Equal(t, input.GovKeeper.GetTallyParams(ctx), keeper.TallyParams(ctx, other))
+}
+
+func TestTallyWithProposalTypeParams(t *testing.T) {
+	text := NewProposalType(gov.RouterKey, gov.ProposalTypeText)
+	for _, tc := range []struct {
+		name        string
+		tallyParams []ProposalTallyParams
+		status      gov.ProposalStatus
+	}{
+		{"gov tally params", nil, gov.StatusPassed},
+		{"higher threshold", []ProposalTallyParams{NewProposalTallyParams(text, gov.NewTallyParams(
+			sdk.NewDecWithPrec(334, 3), sdk.NewDecWithPrec(8, 1), sdk.NewDecWithPrec(334, 3),
+		))}, gov.StatusRejected},
+		{"higher quorum", []ProposalTallyParams{NewProposalTallyParams(text, gov.NewTallyParams(
+			sdk.NewDecWithPrec(8, 1), sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(334, 3),
+		))}, gov.StatusRejected},
+		{"other proposal type", []ProposalTallyParams{NewProposalTallyParams(NewProposalType("params", "ParameterChange"),
+			gov.NewTallyParams(sdk.OneDec(), sdk.OneDec(), sdk.OneDec()),
+		)}, gov.StatusPassed},
+	} {
+		input, keeper, handler := createTestInput(t)
+		validators := createValidators(t, input)
+		ctx := input.Ctx
+		setParams(ctx, keeper, func(params *Params) {
+			params.ProposalTallyParams = tc.tallyParams
+		})
+		proposalID := submitTextProposal(t, ctx, input, handler, validators[0])
+		// 70% of the voting power votes, and 4/7 of it votes yes
+		vote(t, ctx, handler, proposalID, validators[0], gov.OptionYes)
+		vote(t, ctx, handler, proposalID, validators[1], gov.OptionNo)
+
+		ctx = input.NextBlock(votingPeriod(ctx, input))
+		EndBlocker(ctx, keeper)
+		proposal, _ := input.GovKeeper.GetProposal(ctx, proposalID)
+		require.Equal(t, tc.status, proposal.Status, tc.name)
+	}
+}
+
+func TestProposalTallyParamsValidate(t *testing.T) {
+	text := NewProposalType(gov.RouterKey, gov.ProposalTypeText)
+	valid := gov.NewTallyParams(sdk.NewDecWithPrec(334, 3), sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(334, 3))
+	require.NoError(t, NewProposalTallyParams(text, valid).Validate())
+
+	for _, tallyParams := range []ProposalTallyParams{
+		NewProposalTallyParams(NewProposalType("", gov.ProposalTypeText), valid),
+		NewProposalTallyParams(text, gov.NewTallyParams(sdk.ZeroDec(), valid.Threshold, valid.Veto)),
+		NewProposalTallyParams(text, gov.NewTallyParams(valid.Quorum, sdk.NewDec(2), valid.Veto)),
+		NewProposalTallyParams(text, gov.NewTallyParams(valid.Quorum, valid.Threshold, sdk.Dec{})),
+	} {
+		require.Error(t, tallyParams.Validate(), "%+v", tallyParams)
+	}
+
+	params := DefaultParams()
+	params.ProposalTallyParams = []ProposalTallyParams{NewProposalTallyParams(text, valid), NewProposalTallyParams(text, valid)}
+	require.Error(t, params.Validate(gov.DefaultPeriod))
+}
+
+// tallyWithVotes tallies a text proposal at its voting end, with validators of 60%, 20% and 20% of the voting power
+// voting yes, no and no, and a delegator of the second validator voting yes
+func tallyWithVotes(t *testing.T, update func(params *Params)) gov.Proposal {
+	input, keeper, handler := createTestInput(t)
+	validators := []sdk.AccAddress{
+		sdk.AccAddress(input.CreateValidator(t, 0, 60)),
+		sdk.AccAddress(input.CreateValidator(t, 1, 10)),
+		sdk.AccAddress(input.CreateValidator(t, 2, 20)),
+	}
+	input.Delegate(t, 3, sdk.ValAddress(validators[1]), 10)
+	ctx := input.Ctx
+	setParams(ctx, keeper, func(params *Params) {
+		params.VoterMode = ModeAnyAccount
+		update(params)
+	})
+	proposalID := submitTextProposal(t, ctx, input, handler, validators[0])
+	vote(t, ctx, handler, proposalID, validators[0], gov.OptionYes)
+	vote(t, ctx, handler, proposalID, validators[1], gov.OptionNo)
+	vote(t, ctx, handler, proposalID, validators[2], gov.OptionNo)
+	vote(t, ctx, handler, proposalID, addrs[3], gov.OptionYes)
+
+	ctx = input.NextBlock(votingPeriod(ctx, input))
+	EndBlocker(ctx, keeper)
+	proposal, _ := input.GovKeeper.GetProposal(ctx, proposalID)
+	return proposal
+}
+
+func TestTallyModes(t *testing.T) {
+	proposal := tallyWithVotes(t, func(params *Params) {})
+	require.Equal(t, gov.StatusPassed, proposal.Status)
+	require.Equal(t, sdk.TokensFromConsensusPower(70), proposal.FinalTallyResult.Yes)
+	require.Equal(t, sdk.TokensFromConsensusPower(30), proposal.FinalTallyResult.No)
+
+	// the delegator vote is ignored, and the two validators voting no outnumber the one voting yes
+	proposal = tallyWithVotes(t, func(params *Params) {
+		params.TallyMode = TallyModeOneValidatorOneVote
+	})
+	require.Equal(t, gov.StatusRejected, proposal.Status)
+	require.Equal(t, sdk.OneInt(), proposal.FinalTallyResult.Yes)
+	require.Equal(t, sdk.NewInt(2), proposal.FinalTallyResult.No)
+}
+
+func TestTallyValidatorPowerCap(t *testing.T) {
+	// the first validator is capped at 20 of the total bonded 100, so the yes votes no longer outweigh the no votes
+	proposal := tallyWithVotes(t, func(params *Params) {
+		params.ValidatorPowerCap = sdk.NewDecWithPrec(2, 1)
+	})
+	require.Equal(t, gov.StatusRejected, proposal.Status)
+	require.Equal(t, sdk.TokensFromConsensusPower(30), proposal.FinalTallyResult.Yes)
+	require.Equal(t, sdk.TokensFromConsensusPower(30), proposal.FinalTallyResult.No)
+
+	// a cap rounding the maximum bonded tokens down to zero is ignored, instead of failing every proposal
+	proposal = tallyWithVotes(t, func(params *Params) {
+		params.ValidatorPowerCap = sdk.SmallestDec()
+	})
+	require.Equal(t, gov.StatusPassed, proposal.Status)
+	require.Equal(t, sdk.TokensFromConsensusPower(70), proposal.FinalTallyResult.Yes)
+}
+
+func TestQueryTally(t *testing.T) {
+	input, keeper, handler := createTestInput(t)
+	validators := createValidators(t, input)
+	ctx := input.Ctx
+	setParams(ctx, keeper, func(params *Params) {
+		params.TallyMode = TallyModeOneValidatorOneVote
+	})
+	querier := NewQuerier(keeper, gov.NewQuerier(input.GovKeeper))
+	queryTally := func(proposalID uint64) gov.TallyResult {
+		req := abci.RequestQuery{Data: input.Cdc.MustMarshalJSON(gov.NewQueryProposalParams(proposalID))}
+		res, err := querier(ctx, []string{gov.QueryTally}, req)
+		require.NoError(t, err)
+		var tallyResult gov.TallyResult
+		input.Cdc.MustUnmarshalJSON(res, &tallyResult)
+		return tallyResult
+	}
+
+	proposalID := submitTextProposal(t, ctx, input, handler, validators[0])
+	vote(t, ctx, handler, proposalID, validators[0], gov.OptionYes)
+	vote(t, ctx, handler, proposalID, validators[1], gov.OptionNo)
+	tallyResult := queryTally(proposalID)
+	require.Equal(t, sdk.OneInt(), tallyResult.Yes)
+	require.Equal(t, sdk.OneInt(), tallyResult.No)
+
+	// tallied proposals are left to the gov querier, which returns the final tally result
+	ctx = input.NextBlock(votingPeriod(ctx, input))
+	EndBlocker(ctx, keeper)
+	proposal, _ := input.GovKeeper.GetProposal(ctx, proposalID)
+	require.Equal(t, proposal.FinalTallyResult, queryTally(proposalID))
+}
@@ -0,0 +1,225 @@
+package gov
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/libs/log"
+	tmtypes "github.com/tendermint/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+)
+
+// dummy accounts used in tests, each funded with initTokens
+var (
+	pubKeys = createTestPubKeys(100)
+	addrs   = createTestAddrs(pubKeys)
+
+	initTokens = sdk.TokensFromConsensusPower(1000)
+)
+
+func createTestPubKeys(n int) []crypto.PubKey {
+	pubKeys := make([]crypto.PubKey, n)
+	for i := range pubKeys {
+		var secret [8]byte
+		secret[0], secret[1] = byte(i>>8), byte(i)
+		pubKeys[i] = ed25519.GenPrivKeyFromSecret(secret[:]).PubKey()
+	}
+	return pubKeys
+}
+
+func createTestAddrs(pubKeys []crypto.PubKey) []sdk.AccAddress {
+	addrs := make([]sdk.AccAddress, len(pubKeys))
+	for i, pubKey := range pubKeys {
+		addrs[i] = sdk.AccAddress(pubKey.Address())
+	}
+	return addrs
+}
+
+func makeTestCodec() *codec.Codec {
+	cdc := codec.New()
+	sdk.RegisterCodec(cdc)
+	codec.RegisterCrypto(cdc)
+	auth.RegisterCodec(cdc)
+	bank.RegisterCodec(cdc)
+	staking.RegisterCodec(cdc)
+	supply.RegisterCodec(cdc)
+	gov.RegisterCodec(cdc)
+	RegisterCodec(cdc)
+	return cdc
+}
+
+// testInput holds the context and the keepers sharing the same multistore
+type testInput struct {
+	Ctx sdk.Context
+	Cdc *codec.Codec
+
+	BankKeeper    bank.Keeper
+	SupplyKeeper  supply.Keeper
+	StakingKeeper staking.Keeper
+	GovKeeper     gov.Keeper
+}
+
+// createTestInput returns the input with the wrapper keeper using the default params, and the wrapped gov handler
+func createTestInput(t testing.TB) (*testInput, Keeper, sdk.Handler) {
+	keys := sdk.NewKVStoreKeys(
+		auth.StoreKey, params.StoreKey, supply.StoreKey, staking.StoreKey, gov.StoreKey, StoreKey,
+	)
+	tkeys := sdk.NewTransientStoreKeys(params.TStoreKey, staking.TStoreKey)
+
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+	for _, key := range keys {
+		ms.MountStoreWithDB(key, sdk.StoreTypeIAVL, db)
+	}
+	for _, tkey := range tkeys {
+		ms.MountStoreWithDB(tkey, sdk.StoreTypeTransient, db)
+	}
+	err := ms.LoadLatestVersion()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	header := abci.Header{ChainID: "likechain-test", Height: 1, Time: time.Unix(1500000000, 0).UTC()}
+	ctx := sdk.NewContext(ms, header, false, log.NewNopLogger())
+	ctx = ctx.WithConsensusParams(&abci.ConsensusParams{
+		Validator: &abci.ValidatorParams{PubKeyTypes: []string{tmtypes.ABCIPubKeyTypeEd25519}},
+	})
+	cdc := makeTestCodec()
+
+	maccPerms := map[string][]string{
+		staking.BondedPoolName:    {supply.Burner, supply.Staking},
+		staking.NotBondedPoolName: {supply.Burner, supply.Staking},
+		gov.ModuleName:            {supply.Burner},
+	}
+	blacklistedAddrs := map[string]bool{}
+	for name := range maccPerms {
+		blacklistedAddrs[supply.NewModuleAddress(name).String()] = true
+	}
+
+	paramsKeeper := params.NewKeeper(cdc, keys[params.StoreKey], tkeys[params.TStoreKey], params.DefaultCodespace)
+	accountKeeper := auth.NewAccountKeeper(cdc, keys[auth.StoreKey], paramsKeeper.Subspace(auth.DefaultParamspace),
+		auth.ProtoBaseAccount)
+	bankKeeper := bank.NewBaseKeeper(accountKeeper, paramsKeeper.Subspace(bank.DefaultParamspace), bank.DefaultCodespace,
+		blacklistedAddrs)
+	supplyKeeper := supply.NewKeeper(cdc, keys[supply.StoreKey], accountKeeper, bankKeeper, maccPerms)
+	stakingKeeper := staking.NewKeeper(cdc, keys[staking.StoreKey], tkeys[staking.TStoreKey], supplyKeeper,
+		paramsKeeper.Subspace(staking.DefaultParamspace), staking.DefaultCodespace)
+	govRouter := gov.NewRouter().AddRoute(gov.RouterKey, gov.ProposalHandler)
+	govKeeper := gov.NewKeeper(cdc, keys[gov.StoreKey], paramsKeeper, paramsKeeper.Subspace(gov.DefaultParamspace),
+		supplyKeeper, stakingKeeper, gov.DefaultCodespace, govRouter)
+	keeper := NewKeeper(
+		cdc, keys[StoreKey], paramsKeeper.Subspace(DefaultParamspace), stakingKeeper, govKeeper, keys[gov.StoreKey],
+		govRouter, DefaultCodespace,
+	)
+
+	bankKeeper.SetSendEnabled(ctx, true)
+	stakingKeeper.SetParams(ctx, staking.DefaultParams())
+	totalSupply := sdk.NewCoins()
+	for _, addr := range addrs {
+		coins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, initTokens))
+		_, err := bankKeeper.AddCoins(ctx, addr, coins)
+		if err != nil {
+			t.Fatal(err)
+		}
+		totalSupply = totalSupply.Add(coins)
+	}
+	supplyKeeper.SetSupply(ctx, supply.NewSupply(totalSupply))
+	for name, perms := range maccPerms {
+		supplyKeeper.SetModuleAccount(ctx, supply.NewEmptyModuleAccount(name, perms...))
+	}
+	gov.InitGenesis(ctx, govKeeper, supplyKeeper, gov.DefaultGenesisState())
+	InitGenesis(ctx, keeper, DefaultGenesisState())
+
+	input := &testInput{
+		Ctx:           ctx,
+		Cdc:           cdc,
+		BankKeeper:    bankKeeper,
+		SupplyKeeper:  supplyKeeper,
+		StakingKeeper: stakingKeeper,
+		GovKeeper:     govKeeper,
+	}
+	return input, keeper, WrapGovHandler(keeper, gov.NewHandler(govKeeper))
+}
+
+// CreateValidator creates a validator operated by addrs[i] with the self-delegation of the given consensus power, and
+// applies the validator set updates so that the validator is bonded
+func (input *testInput) CreateValidator(t testing.TB, i int, power int64) sdk.ValAddress {
+	valAddr := sdk.ValAddress(addrs[i])
+	tokens := sdk.TokensFromConsensusPower(power)
+	msg := staking.NewMsgCreateValidator(
+		valAddr, pubKeys[i], sdk.NewCoin(sdk.DefaultBondDenom, tokens),
+		staking.NewDescription(fmt.Sprintf("validator-%d", i), "", "", ""),
+		staking.NewCommissionRates(sdk.ZeroDec(), sdk.OneDec(), sdk.ZeroDec()), sdk.OneInt(),
+	)
+	result := staking.NewHandler(input.StakingKeeper)(input.Ctx, msg)
+	if !result.IsOK() {
+		t.Fatalf("cannot create validator %d: %s", i, result.Log)
+	}
+	staking.EndBlocker(input.Ctx, input.StakingKeeper)
+	return valAddr
+}
+
+// Delegate delegates the tokens of the given consensus power from addrs[i] to the validator
+func (input *testInput) Delegate(t testing.TB, i int, valAddr sdk.ValAddress, power int64) {
+	tokens := sdk.TokensFromConsensusPower(power)
+	msg := staking.NewMsgDelegate(addrs[i], valAddr, sdk.NewCoin(sdk.DefaultBondDenom, tokens))
+	result := staking.NewHandler(input.StakingKeeper)(input.Ctx, msg)
+	if !result.IsOK() {
+		t.Fatalf("cannot delegate from %d: %s", i, result.Log)
+	}
+	staking.EndBlocker(input.Ctx, input.StakingKeeper)
+}
+
+// NextBlock returns the context of the next block, the given duration after the current block
+func (input *testInput) NextBlock(d time.Duration) sdk.Context {
+	header := input.Ctx.BlockHeader()
+	header.Height++
+	header.Time = header.Time.Add(d)
+	input.Ctx = input.Ctx.WithBlockHeader(header)
+	return input.Ctx
+}
+
+func setParams(ctx sdk.Context, keeper Keeper, update func(params *Params)) {
+	params := keeper.GetParams(ctx)
+	update(&params)
+	keeper.SetParams(ctx, params)
+}
+
+func minDeposit(ctx sdk.Context, input *testInput) sdk.Coins {
+	return input.GovKeeper.GetDepositParams(ctx).MinDeposit
+}
+
+func textProposal() gov.Content {
+	return gov.NewTextProposal("title", "description")
+}
+
+// submitTextProposal submits a text proposal from the proposer with the minimum deposit, and returns the proposal ID
+func submitTextProposal(t *testing.T, ctx sdk.Context, input *testInput, handler sdk.Handler, proposer sdk.AccAddress) uint64 {
+	msg := gov.NewMsgSubmitProposal(textProposal(), minDeposit(ctx, input), proposer)
+	result := handler(ctx, msg)
+	require.True(t, result.IsOK(), result.Log)
+	var proposalID uint64
+	input.Cdc.MustUnmarshalBinaryLengthPrefixed(result.Data, &proposalID)
+	return proposalID
+}
+
+func vote(t *testing.T, ctx sdk.Context, handler sdk.Handler, proposalID uint64, voter sdk.AccAddress, option gov.VoteOption) {
+	result := handler(ctx, gov.NewMsgVote(voter, proposalID, option))
+	require.True(t, result.IsOK(), result.Log)
+}
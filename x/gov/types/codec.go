@@ -0,0 +1,22 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+)
+
+func RegisterCodec(cdc *codec.Codec) {
+	cdc.RegisterConcrete(MsgAuthorizeVoter{}, "likechain/MsgAuthorizeVoter", nil)
+	cdc.RegisterConcrete(MsgRevokeVoter{}, "likechain/MsgRevokeVoter", nil)
+	cdc.RegisterConcrete(MsgSubmitEmergencyProposal{}, "likechain/MsgSubmitEmergencyProposal", nil)
+}
+
+var ModuleCdc *codec.Codec
+
+func init() {
+	ModuleCdc = codec.New()
+	gov.RegisterCodec(ModuleCdc)
+	RegisterCodec(ModuleCdc)
+	codec.RegisterCrypto(ModuleCdc)
+	ModuleCdc.Seal()
+}
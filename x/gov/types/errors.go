@@ -0,0 +1,71 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	DefaultCodespace sdk.CodespaceType = "likechain/gov"
+
+	CodeProposerNotAllowed sdk.CodeType = 1
+	CodeVoterNotAllowed    sdk.CodeType = 2
+	CodeInvalidValidator   sdk.CodeType = 3
+	CodeInvalidVoter       sdk.CodeType = 4
+	CodeVoterNotFound      sdk.CodeType = 5
+
+	CodeProposalTypeNotAllowed sdk.CodeType = 6
+	CodeInvalidProposal        sdk.CodeType = 7
+	CodeProposalTallied        sdk.CodeType = 8
+	CodeProposalRateLimited    sdk.CodeType = 9
+)
+
+func ErrProposerNotAllowed(codespace sdk.CodespaceType, mode ParticipationMode) sdk.Error {
+	return sdk.NewError(codespace, CodeProposerNotAllowed, fmt.Sprintf("proposer not allowed to submit proposals (proposer mode: %s)", mode))
+}
+
+func ErrVoterNotAllowed(codespace sdk.CodespaceType, mode ParticipationMode) sdk.Error {
+	return sdk.NewError(codespace, CodeVoterNotAllowed, fmt.Sprintf("voter not allowed to vote (voter mode: %s)", mode))
+}
+
+func ErrInvalidValidator(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidValidator, "validator does not exist")
+}
+
+func ErrInvalidVoter(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidVoter, "voter address is invalid")
+}
+
+func ErrVoterAlreadyAuthorized(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidVoter, "voter is already authorized by another validator")
+}
+
+func ErrVoterNotFound(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeVoterNotFound, "no voter authorized by the validator")
+}
+
+func ErrProposalTypeNotAllowed(codespace sdk.CodespaceType, proposalType ProposalType) sdk.Error {
+	return sdk.NewError(codespace, CodeProposalTypeNotAllowed, fmt.Sprintf("proposal type %s is not allowed", proposalType))
+}
+
+func ErrEmergencyProposerNotValidator(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeProposerNotAllowed, "only bonded validators can submit emergency proposals")
+}
+
+func ErrUnknownProposal(codespace sdk.CodespaceType, proposalID uint64) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidProposal, fmt.Sprintf("unknown proposal %d", proposalID))
+}
+
+func ErrProposalTallied(codespace sdk.CodespaceType, proposalID uint64) sdk.Error {
+	return sdk.NewError(codespace, CodeProposalTallied, fmt.Sprintf("proposal %d has been tallied, query the proposal for its final tally result", proposalID))
+}
+
+func ErrTooManyActiveProposals(codespace sdk.CodespaceType, max uint64) sdk.Error {
+	return sdk.NewError(codespace, CodeProposalRateLimited, fmt.Sprintf("proposer already has %d active proposals", max))
+}
+
+func ErrTooManyProposalsInWindow(codespace sdk.CodespaceType, max uint64, window time.Duration) sdk.Error {
+	return sdk.NewError(codespace, CodeProposalRateLimited, fmt.Sprintf("proposer already submitted %d proposals in the last %s", max, window))
+}
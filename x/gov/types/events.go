@@ -0,0 +1,14 @@
+package types
+
+var (
+	EventTypeAuthorizeVoter = "authorize_voter"
+	EventTypeRevokeVoter    = "revoke_voter"
+	EventTypeDelegatedVote  = "delegated_vote"
+
+	EventTypeEmergencyProposal = "emergency_proposal"
+
+	AttributeKeyValidator  = "validator"
+	AttributeKeyVoter      = "voter"
+	AttributeKeyProposalID = "proposal_id"
+	AttributeValueCategory = ModuleName
+)
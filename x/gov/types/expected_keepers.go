@@ -0,0 +1,15 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+	"github.com/cosmos/cosmos-sdk/x/staking/exported"
+)
+
+// StakingKeeper expected staking keeper for the gov wrapper
+type StakingKeeper interface {
+	gov.StakingKeeper
+
+	Validator(ctx sdk.Context, addr sdk.ValAddress) exported.ValidatorI
+	IterateValidators(ctx sdk.Context, fn func(index int64, validator exported.ValidatorI) (stop bool))
+}
@@ -0,0 +1,73 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/x/gov"
+)
+
+// GenesisState is the wrapper specific part of the gov genesis state.
+// It is stored alongside the fields of the original gov genesis state.
+type GenesisState struct {
+	Params              Params               `json:"wrapper_params,omitempty" yaml:"wrapper_params"`
+	VoterAuthorizations []VoterAuthorization `json:"voter_authorizations,omitempty" yaml:"voter_authorizations"`
+	ProposalSubmissions []ProposalSubmission `json:"proposal_submissions,omitempty" yaml:"proposal_submissions"`
+	EmergencyProposals  []uint64             `json:"emergency_proposals,omitempty" yaml:"emergency_proposals"`
+}
+
+func DefaultGenesisState() GenesisState {
+	return GenesisState{
+		Params: DefaultParams(),
+	}
+}
+
+// ValidateGenesis validates the wrapper genesis state against the gov genesis state sharing the same JSON object
+func ValidateGenesis(data GenesisState, govData gov.GenesisState) error {
+	err := data.Params.Validate(govData.VotingParams.VotingPeriod)
+	if err != nil {
+		return err
+	}
+	validators := map[string]bool{}
+	voters := map[string]bool{}
+	for _, auth := range data.VoterAuthorizations {
+		if auth.ValidatorAddress.Empty() || auth.Voter.Empty() {
+			return fmt.Errorf("empty address in voter authorization")
+		}
+		if validators[auth.ValidatorAddress.String()] {
+			return fmt.Errorf("duplicated voter authorization for validator %s", auth.ValidatorAddress)
+		}
+		if voters[auth.Voter.String()] {
+			return fmt.Errorf("voter %s authorized by more than one validator", auth.Voter)
+		}
+		validators[auth.ValidatorAddress.String()] = true
+		voters[auth.Voter.String()] = true
+	}
+	proposalIDs := map[uint64]bool{}
+	for _, submission := range data.ProposalSubmissions {
+		if submission.Proposer.Empty() {
+			return fmt.Errorf("empty proposer in proposal submission %d", submission.ProposalID)
+		}
+		if proposalIDs[submission.ProposalID] {
+			return fmt.Errorf("duplicated proposal submission %d", submission.ProposalID)
+		}
+		proposalIDs[submission.ProposalID] = true
+	}
+	// only proposals not yet tallied or dropped are marked as emergency proposals
+	pendingProposals := map[uint64]bool{}
+	for _, proposal := range govData.Proposals {
+		if proposal.Status == gov.StatusDepositPeriod || proposal.Status == gov.StatusVotingPeriod {
+			pendingProposals[proposal.ProposalID] = true
+		}
+	}
+	emergencyProposals := map[uint64]bool{}
+	for _, proposalID := range data.EmergencyProposals {
+		if !pendingProposals[proposalID] {
+			return fmt.Errorf("emergency proposal %d is not in deposit or voting period", proposalID)
+		}
+		if emergencyProposals[proposalID] {
+			return fmt.Errorf("duplicated emergency proposal %d", proposalID)
+		}
+		emergencyProposals[proposalID] = true
+	}
+	return nil
+}
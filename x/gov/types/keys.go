@@ -0,0 +1,67 @@
+package types
+
+import (
+	"encoding/binary"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+)
+
+const (
+	ModuleName = "likegov"
+	StoreKey   = ModuleName
+
+	// messages of the wrapper are handled by the wrapped gov handler, so they share the same route
+	RouterKey = gov.RouterKey
+)
+
+var (
+	VoterKeyPrefix          = []byte{0x11}
+	VoterValidatorKeyPrefix = []byte{0x12}
+
+	EmergencyProposalKeyPrefix = []byte{0x13}
+
+	ProposalSubmissionKeyPrefix = []byte{0x14}
+	ProposalProposerKeyPrefix   = []byte{0x15}
+)
+
+// VoterKey gets the key for the authorized voter of a validator
+func VoterKey(valAddr sdk.ValAddress) []byte {
+	return append(VoterKeyPrefix, valAddr.Bytes()...)
+}
+
+// VoterValidatorKey gets the key for the validator which authorized the voter
+func VoterValidatorKey(voter sdk.AccAddress) []byte {
+	return append(VoterValidatorKeyPrefix, voter.Bytes()...)
+}
+
+func proposalIDBytes(proposalID uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, proposalID)
+	return bz
+}
+
+// EmergencyProposalKey gets the key marking the proposal as an emergency proposal
+func EmergencyProposalKey(proposalID uint64) []byte {
+	return append(EmergencyProposalKeyPrefix, proposalIDBytes(proposalID)...)
+}
+
+// SplitEmergencyProposalKey gets the proposal ID from the emergency proposal key
+func SplitEmergencyProposalKey(key []byte) (proposalID uint64) {
+	return binary.BigEndian.Uint64(key[len(EmergencyProposalKeyPrefix):])
+}
+
+// ProposerSubmissionsPrefix gets the prefix of the proposal submissions of the proposer
+func ProposerSubmissionsPrefix(proposer sdk.AccAddress) []byte {
+	return append(ProposalSubmissionKeyPrefix, proposer.Bytes()...)
+}
+
+// ProposalSubmissionKey gets the key for the submission record of the proposal, grouped by proposer
+func ProposalSubmissionKey(proposer sdk.AccAddress, proposalID uint64) []byte {
+	return append(ProposerSubmissionsPrefix(proposer), proposalIDBytes(proposalID)...)
+}
+
+// ProposalProposerKey gets the key for the proposer of the proposal
+func ProposalProposerKey(proposalID uint64) []byte {
+	return append(ProposalProposerKeyPrefix, proposalIDBytes(proposalID)...)
+}
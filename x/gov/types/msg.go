@@ -0,0 +1,114 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+)
+
+var (
+	_ sdk.Msg = &MsgAuthorizeVoter{}
+	_ sdk.Msg = &MsgRevokeVoter{}
+	_ sdk.Msg = &MsgSubmitEmergencyProposal{}
+)
+
+// MsgAuthorizeVoter authorizes an account to vote on behalf of a validator, so the operator key can be kept offline
+type MsgAuthorizeVoter struct {
+	ValidatorAddress sdk.ValAddress `json:"validator_address" yaml:"validator_address"`
+	Voter            sdk.AccAddress `json:"voter" yaml:"voter"`
+}
+
+func NewMsgAuthorizeVoter(valAddr sdk.ValAddress, voter sdk.AccAddress) MsgAuthorizeVoter {
+	return MsgAuthorizeVoter{
+		ValidatorAddress: valAddr,
+		Voter:            voter,
+	}
+}
+
+func (msg MsgAuthorizeVoter) Route() string { return RouterKey }
+func (msg MsgAuthorizeVoter) Type() string  { return "authorize_voter" }
+
+func (msg MsgAuthorizeVoter) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.ValidatorAddress)}
+}
+
+func (msg MsgAuthorizeVoter) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgAuthorizeVoter) ValidateBasic() sdk.Error {
+	if msg.ValidatorAddress.Empty() {
+		return ErrInvalidValidator(DefaultCodespace)
+	}
+	if msg.Voter.Empty() || msg.Voter.Equals(msg.ValidatorAddress) {
+		return ErrInvalidVoter(DefaultCodespace)
+	}
+	return nil
+}
+
+// MsgRevokeVoter revokes the voter authorized by a validator
+type MsgRevokeVoter struct {
+	ValidatorAddress sdk.ValAddress `json:"validator_address" yaml:"validator_address"`
+}
+
+func NewMsgRevokeVoter(valAddr sdk.ValAddress) MsgRevokeVoter {
+	return MsgRevokeVoter{
+		ValidatorAddress: valAddr,
+	}
+}
+
+func (msg MsgRevokeVoter) Route() string { return RouterKey }
+func (msg MsgRevokeVoter) Type() string  { return "revoke_voter" }
+
+func (msg MsgRevokeVoter) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.ValidatorAddress)}
+}
+
+func (msg MsgRevokeVoter) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgRevokeVoter) ValidateBasic() sdk.Error {
+	if msg.ValidatorAddress.Empty() {
+		return ErrInvalidValidator(DefaultCodespace)
+	}
+	return nil
+}
+
+// MsgSubmitEmergencyProposal submits a proposal with the emergency voting period and threshold
+type MsgSubmitEmergencyProposal struct {
+	Content        gov.Content    `json:"content" yaml:"content"`
+	InitialDeposit sdk.Coins      `json:"initial_deposit" yaml:"initial_deposit"`
+	Proposer       sdk.AccAddress `json:"proposer" yaml:"proposer"`
+}
+
+func NewMsgSubmitEmergencyProposal(content gov.Content, initialDeposit sdk.Coins, proposer sdk.AccAddress) MsgSubmitEmergencyProposal {
+	return MsgSubmitEmergencyProposal{
+		Content:        content,
+		InitialDeposit: initialDeposit,
+		Proposer:       proposer,
+	}
+}
+
+func (msg MsgSubmitEmergencyProposal) Route() string { return RouterKey }
+func (msg MsgSubmitEmergencyProposal) Type() string  { return "submit_emergency_proposal" }
+
+func (msg MsgSubmitEmergencyProposal) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Proposer}
+}
+
+// the gov codec is used since it has all the proposal content types registered
+func (msg MsgSubmitEmergencyProposal) GetSignBytes() []byte {
+	bz := gov.ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgSubmitEmergencyProposal) ValidateBasic() sdk.Error {
+	return msg.ToMsgSubmitProposal().ValidateBasic()
+}
+
+// ToMsgSubmitProposal converts the message to the original gov MsgSubmitProposal
+func (msg MsgSubmitEmergencyProposal) ToMsgSubmitProposal() gov.MsgSubmitProposal {
+	return gov.NewMsgSubmitProposal(msg.Content, msg.InitialDeposit, msg.Proposer)
+}
@@ -0,0 +1,279 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+	"github.com/cosmos/cosmos-sdk/x/params"
+)
+
+// ParticipationMode defines which accounts are allowed to submit proposals or vote
+type ParticipationMode string
+
+const (
+	ModeBondedValidators ParticipationMode = "bonded_validators"
+	ModeValidators       ParticipationMode = "validators"
+	ModeAnyAccount       ParticipationMode = "any"
+	ModeAllowlist        ParticipationMode = "allowlist"
+)
+
+func (mode ParticipationMode) IsValid() bool {
+	switch mode {
+	case ModeBondedValidators, ModeValidators, ModeAnyAccount, ModeAllowlist:
+		return true
+	}
+	return false
+}
+
+// TallyMode defines how the voting power of the votes is counted
+type TallyMode string
+
+const (
+	// each vote counts with the bonded tokens behind it, as in x/gov
+	TallyModeStakeWeighted TallyMode = "stake_weighted"
+	// each bonded validator counts as one vote, and votes from delegators are ignored
+	TallyModeOneValidatorOneVote TallyMode = "one_validator_one_vote"
+)
+
+func (mode TallyMode) IsValid() bool {
+	switch mode {
+	case TallyModeStakeWeighted, TallyModeOneValidatorOneVote:
+		return true
+	}
+	return false
+}
+
+// ProposalType identifies a kind of proposal content by its route and type
+type ProposalType struct {
+	Route string `json:"route" yaml:"route"`
+	Type  string `json:"type" yaml:"type"`
+}
+
+func NewProposalType(route, proposalType string) ProposalType {
+	return ProposalType{
+		Route: route,
+		Type:  proposalType,
+	}
+}
+
+func (t ProposalType) String() string {
+	return fmt.Sprintf("%s/%s", t.Route, t.Type)
+}
+
+// ProposalTallyParams overrides the gov tally params for proposals of the given type
+type ProposalTallyParams struct {
+	ProposalType ProposalType    `json:"proposal_type" yaml:"proposal_type"`
+	TallyParams  gov.TallyParams `json:"tally_params" yaml:"tally_params"`
+}
+
+func NewProposalTallyParams(proposalType ProposalType, tallyParams gov.TallyParams) ProposalTallyParams {
+	return ProposalTallyParams{
+		ProposalType: proposalType,
+		TallyParams:  tallyParams,
+	}
+}
+
+func (p ProposalTallyParams) Validate() error {
+	if p.ProposalType.Route == "" || p.ProposalType.Type == "" {
+		return fmt.Errorf("invalid proposal type in tally params: %s", p.ProposalType)
+	}
+	isValidRatio := func(ratio sdk.Dec) bool {
+		return !ratio.IsNil() && ratio.IsPositive() && ratio.LTE(sdk.OneDec())
+	}
+	if !isValidRatio(p.TallyParams.Quorum) {
+		return fmt.Errorf("quorum of %s must be positive and at most 1: %s", p.ProposalType, p.TallyParams.Quorum)
+	}
+	if !isValidRatio(p.TallyParams.Threshold) {
+		return fmt.Errorf("threshold of %s must be positive and at most 1: %s", p.ProposalType, p.TallyParams.Threshold)
+	}
+	if !isValidRatio(p.TallyParams.Veto) {
+		return fmt.Errorf("veto of %s must be positive and at most 1: %s", p.ProposalType, p.TallyParams.Veto)
+	}
+	return nil
+}
+
+type Params struct {
+	ProposerMode      ParticipationMode `json:"proposer_mode" yaml:"proposer_mode"`
+	VoterMode         ParticipationMode `json:"voter_mode" yaml:"voter_mode"`
+	ProposerAllowlist []sdk.AccAddress  `json:"proposer_allowlist" yaml:"proposer_allowlist"`
+	VoterAllowlist    []sdk.AccAddress  `json:"voter_allowlist" yaml:"voter_allowlist"`
+
+	// empty allowlist allows all proposal types registered on the gov router, except those in the denylist
+	ProposalTypeAllowlist []ProposalType `json:"proposal_type_allowlist" yaml:"proposal_type_allowlist"`
+	ProposalTypeDenylist  []ProposalType `json:"proposal_type_denylist" yaml:"proposal_type_denylist"`
+
+	// emergency proposals pass once the yes votes reach the threshold of the total bonded voting power
+	EmergencyVotingPeriod time.Duration `json:"emergency_voting_period" yaml:"emergency_voting_period"`
+	EmergencyThreshold    sdk.Dec       `json:"emergency_threshold" yaml:"emergency_threshold"`
+
+	// proposal types not listed here are tallied with the gov tally params
+	ProposalTallyParams []ProposalTallyParams `json:"proposal_tally_params" yaml:"proposal_tally_params"`
+
+	// in stake weighted mode, the voting power of each validator is capped at the fraction of the total bonded tokens
+	// given by the cap, where zero means no cap
+	TallyMode         TallyMode `json:"tally_mode" yaml:"tally_mode"`
+	ValidatorPowerCap sdk.Dec   `json:"validator_power_cap" yaml:"validator_power_cap"`
+
+	// limits on the proposals submitted by each proposer, where zero means no limit
+	MaxActiveProposals    uint64        `json:"max_active_proposals" yaml:"max_active_proposals"`
+	MaxProposalsPerWindow uint64        `json:"max_proposals_per_window" yaml:"max_proposals_per_window"`
+	ProposalRateWindow    time.Duration `json:"proposal_rate_window" yaml:"proposal_rate_window"`
+}
+
+var (
+	KeyProposerMode      = []byte("ProposerMode")
+	KeyVoterMode         = []byte("VoterMode")
+	KeyProposerAllowlist = []byte("ProposerAllowlist")
+	KeyVoterAllowlist    = []byte("VoterAllowlist")
+
+	KeyProposalTypeAllowlist = []byte("ProposalTypeAllowlist")
+	KeyProposalTypeDenylist  = []byte("ProposalTypeDenylist")
+
+	KeyEmergencyVotingPeriod = []byte("EmergencyVotingPeriod")
+	KeyEmergencyThreshold    = []byte("EmergencyThreshold")
+
+	KeyProposalTallyParams = []byte("ProposalTallyParams")
+
+	KeyTallyMode         = []byte("TallyMode")
+	KeyValidatorPowerCap = []byte("ValidatorPowerCap")
+
+	KeyMaxActiveProposals    = []byte("MaxActiveProposals")
+	KeyMaxProposalsPerWindow = []byte("MaxProposalsPerWindow")
+	KeyProposalRateWindow    = []byte("ProposalRateWindow")
+)
+
+var _ params.ParamSet = (*Params)(nil)
+
+// Implements params.ParamSet
+func (p *Params) ParamSetPairs() params.ParamSetPairs {
+	return params.ParamSetPairs{
+		{Key: KeyProposerMode, Value: &p.ProposerMode},
+		{Key: KeyVoterMode, Value: &p.VoterMode},
+		{Key: KeyProposerAllowlist, Value: &p.ProposerAllowlist},
+		{Key: KeyVoterAllowlist, Value: &p.VoterAllowlist},
+		{Key: KeyProposalTypeAllowlist, Value: &p.ProposalTypeAllowlist},
+		{Key: KeyProposalTypeDenylist, Value: &p.ProposalTypeDenylist},
+		{Key: KeyEmergencyVotingPeriod, Value: &p.EmergencyVotingPeriod},
+		{Key: KeyEmergencyThreshold, Value: &p.EmergencyThreshold},
+		{Key: KeyProposalTallyParams, Value: &p.ProposalTallyParams},
+		{Key: KeyTallyMode, Value: &p.TallyMode},
+		{Key: KeyValidatorPowerCap, Value: &p.ValidatorPowerCap},
+		{Key: KeyMaxActiveProposals, Value: &p.MaxActiveProposals},
+		{Key: KeyMaxProposalsPerWindow, Value: &p.MaxProposalsPerWindow},
+		{Key: KeyProposalRateWindow, Value: &p.ProposalRateWindow},
+	}
+}
+
+// DefaultParams keeps the original behaviour of the wrapper, i.e. only bonded validators can submit proposals and vote,
+// and the number of proposals each proposer can submit is not limited
+func DefaultParams() Params {
+	return Params{
+		ProposerMode:          ModeBondedValidators,
+		VoterMode:             ModeBondedValidators,
+		EmergencyVotingPeriod: 24 * time.Hour,
+		EmergencyThreshold:    sdk.NewDecWithPrec(667, 3),
+		TallyMode:             TallyModeStakeWeighted,
+		ValidatorPowerCap:     sdk.ZeroDec(),
+		MaxActiveProposals:    0,
+		MaxProposalsPerWindow: 0,
+		ProposalRateWindow:    7 * 24 * time.Hour,
+	}
+}
+
+// Validate checks the params against the voting period of x/gov, since the emergency voting period must be shorter
+func (p Params) Validate(govVotingPeriod time.Duration) error {
+	if !p.ProposerMode.IsValid() {
+		return fmt.Errorf("invalid proposer mode: %s", p.ProposerMode)
+	}
+	if !p.VoterMode.IsValid() {
+		return fmt.Errorf("invalid voter mode: %s", p.VoterMode)
+	}
+	for _, addr := range p.ProposerAllowlist {
+		if addr.Empty() {
+			return fmt.Errorf("empty address in proposer allowlist")
+		}
+	}
+	for _, addr := range p.VoterAllowlist {
+		if addr.Empty() {
+			return fmt.Errorf("empty address in voter allowlist")
+		}
+	}
+	for _, proposalType := range p.ProposalTypeAllowlist {
+		if proposalType.Route == "" || proposalType.Type == "" {
+			return fmt.Errorf("invalid proposal type in allowlist: %s", proposalType)
+		}
+	}
+	for _, proposalType := range p.ProposalTypeDenylist {
+		if proposalType.Route == "" || proposalType.Type == "" {
+			return fmt.Errorf("invalid proposal type in denylist: %s", proposalType)
+		}
+	}
+	if p.EmergencyVotingPeriod <= 0 || p.EmergencyVotingPeriod >= govVotingPeriod {
+		return fmt.Errorf("emergency voting period must be positive and shorter than the gov voting period %s: %s",
+			govVotingPeriod, p.EmergencyVotingPeriod)
+	}
+	if p.EmergencyThreshold.IsNil() || p.EmergencyThreshold.LTE(sdk.NewDecWithPrec(5, 1)) || p.EmergencyThreshold.GT(sdk.OneDec()) {
+		return fmt.Errorf("emergency threshold must be greater than 0.5 and at most 1: %s", p.EmergencyThreshold)
+	}
+	seenTypes := make(map[ProposalType]bool)
+	for _, tallyParams := range p.ProposalTallyParams {
+		if err := tallyParams.Validate(); err != nil {
+			return err
+		}
+		if seenTypes[tallyParams.ProposalType] {
+			return fmt.Errorf("duplicated tally params for proposal type %s", tallyParams.ProposalType)
+		}
+		seenTypes[tallyParams.ProposalType] = true
+	}
+	if !p.TallyMode.IsValid() {
+		return fmt.Errorf("invalid tally mode: %s", p.TallyMode)
+	}
+	if p.ValidatorPowerCap.IsNil() || p.ValidatorPowerCap.IsNegative() || p.ValidatorPowerCap.GT(sdk.OneDec()) {
+		return fmt.Errorf("validator power cap must be between 0 and 1: %s", p.ValidatorPowerCap)
+	}
+	if p.ProposalRateWindow <= 0 {
+		return fmt.Errorf("proposal rate window must be positive: %s", p.ProposalRateWindow)
+	}
+	return nil
+}
+
+func (p Params) String() string {
+	return fmt.Sprintf(`Params:
+  Proposer Mode:      %s
+  Voter Mode:         %s
+  Proposer Allowlist: %s
+  Voter Allowlist:    %s
+  Allowed Proposal Types: %s
+  Denied Proposal Types:  %s
+  Emergency Voting Period: %s
+  Emergency Threshold:     %s
+  Proposal Tally Params:   %v
+  Tally Mode:              %s
+  Validator Power Cap:     %s
+  Max Active Proposals:     %d
+  Max Proposals Per Window: %d
+  Proposal Rate Window:     %s`,
+		p.ProposerMode, p.VoterMode, p.ProposerAllowlist, p.VoterAllowlist, p.ProposalTypeAllowlist,
+		p.ProposalTypeDenylist, p.EmergencyVotingPeriod, p.EmergencyThreshold, p.ProposalTallyParams, p.TallyMode,
+		p.ValidatorPowerCap,
+		p.MaxActiveProposals, p.MaxProposalsPerWindow, p.ProposalRateWindow)
+}
+
+func MustUnmarshalParams(cdc *codec.Codec, value []byte) Params {
+	params, err := UnmarshalParams(cdc, value)
+	if err != nil {
+		panic(err)
+	}
+	return params
+}
+
+func UnmarshalParams(cdc *codec.Codec, value []byte) (params Params, err error) {
+	err = cdc.UnmarshalBinaryLengthPrefixed(value, &params)
+	if err != nil {
+		return
+	}
+	return
+}
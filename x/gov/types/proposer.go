@@ -0,0 +1,58 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ProposalSubmission records a proposal submitted by a proposer, for limiting the proposal submissions.
+// Inactive submissions are kept until they leave the proposal rate window.
+type ProposalSubmission struct {
+	ProposalID uint64         `json:"proposal_id" yaml:"proposal_id"`
+	Proposer   sdk.AccAddress `json:"proposer" yaml:"proposer"`
+	SubmitTime time.Time      `json:"submit_time" yaml:"submit_time"`
+	Active     bool           `json:"active" yaml:"active"`
+}
+
+func NewProposalSubmission(proposalID uint64, proposer sdk.AccAddress, submitTime time.Time) ProposalSubmission {
+	return ProposalSubmission{
+		ProposalID: proposalID,
+		Proposer:   proposer,
+		SubmitTime: submitTime,
+		Active:     true,
+	}
+}
+
+// ProposerQuota shows the proposal submissions of a proposer against the limits in the params
+type ProposerQuota struct {
+	Proposer           sdk.AccAddress `json:"proposer" yaml:"proposer"`
+	ActiveProposals    uint64         `json:"active_proposals" yaml:"active_proposals"`
+	ProposalsInWindow  uint64         `json:"proposals_in_window" yaml:"proposals_in_window"`
+	RemainingProposals uint64         `json:"remaining_proposals" yaml:"remaining_proposals"`
+	// no limit is set, RemainingProposals should be ignored
+	Unlimited bool `json:"unlimited" yaml:"unlimited"`
+}
+
+func (q ProposerQuota) String() string {
+	remaining := fmt.Sprintf("%d", q.RemainingProposals)
+	if q.Unlimited {
+		remaining = "unlimited"
+	}
+	return fmt.Sprintf(`Proposer Quota:
+  Proposer:            %s
+  Active Proposals:    %d
+  Proposals In Window: %d
+  Remaining Proposals: %s`, q.Proposer, q.ActiveProposals, q.ProposalsInWindow, remaining)
+}
+
+type ProposerQuotas []ProposerQuota
+
+func (qs ProposerQuotas) String() string {
+	out := ""
+	for _, q := range qs {
+		out += q.String() + "\n"
+	}
+	return out
+}
@@ -0,0 +1,49 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	QueryWrapperParams   = "wrapper_params"
+	QueryAuthorizedVoter = "authorized_voter"
+	QueryVoterValidator  = "voter_validator"
+
+	QueryEmergencyProposals = "emergency_proposals"
+	QueryTallyResults       = "tally_results"
+	QueryProposerQuota      = "proposer_quota"
+	QueryProposerQuotas     = "proposer_quotas"
+)
+
+// Params for query 'custom/gov/authorized_voter'
+type QueryAuthorizedVoterParams struct {
+	ValidatorAddress sdk.ValAddress
+}
+
+func NewQueryAuthorizedVoterParams(valAddr sdk.ValAddress) QueryAuthorizedVoterParams {
+	return QueryAuthorizedVoterParams{
+		ValidatorAddress: valAddr,
+	}
+}
+
+// Params for query 'custom/gov/voter_validator'
+type QueryVoterValidatorParams struct {
+	Voter sdk.AccAddress
+}
+
+func NewQueryVoterValidatorParams(voter sdk.AccAddress) QueryVoterValidatorParams {
+	return QueryVoterValidatorParams{
+		Voter: voter,
+	}
+}
+
+// Params for query 'custom/gov/proposer_quota'
+type QueryProposerQuotaParams struct {
+	Proposer sdk.AccAddress
+}
+
+func NewQueryProposerQuotaParams(proposer sdk.AccAddress) QueryProposerQuotaParams {
+	return QueryProposerQuotaParams{
+		Proposer: proposer,
+	}
+}
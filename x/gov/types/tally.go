@@ -0,0 +1,33 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/x/gov"
+)
+
+// TallyResults contains the current tally of a proposal in both tally modes, where Mode is the one applied
+type TallyResults struct {
+	ProposalID          uint64          `json:"proposal_id" yaml:"proposal_id"`
+	Mode                TallyMode       `json:"mode" yaml:"mode"`
+	StakeWeighted       gov.TallyResult `json:"stake_weighted" yaml:"stake_weighted"`
+	OneValidatorOneVote gov.TallyResult `json:"one_validator_one_vote" yaml:"one_validator_one_vote"`
+}
+
+func NewTallyResults(proposalID uint64, mode TallyMode, stakeWeighted, oneValidatorOneVote gov.TallyResult) TallyResults {
+	return TallyResults{
+		ProposalID:          proposalID,
+		Mode:                mode,
+		StakeWeighted:       stakeWeighted,
+		OneValidatorOneVote: oneValidatorOneVote,
+	}
+}
+
+func (r TallyResults) String() string {
+	return fmt.Sprintf(`Tally Results of Proposal %d (mode: %s):
+Stake Weighted:
+  %s
+One Validator One Vote:
+  %s`,
+		r.ProposalID, r.Mode, r.StakeWeighted, r.OneValidatorOneVote)
+}
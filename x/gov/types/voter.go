@@ -0,0 +1,26 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// VoterAuthorization records an account authorized by a validator operator to vote on its behalf
+type VoterAuthorization struct {
+	ValidatorAddress sdk.ValAddress `json:"validator_address" yaml:"validator_address"`
+	Voter            sdk.AccAddress `json:"voter" yaml:"voter"`
+}
+
+func NewVoterAuthorization(valAddr sdk.ValAddress, voter sdk.AccAddress) VoterAuthorization {
+	return VoterAuthorization{
+		ValidatorAddress: valAddr,
+		Voter:            voter,
+	}
+}
+
+func (auth VoterAuthorization) String() string {
+	return fmt.Sprintf(`Voter Authorization:
+  Validator: %s
+  Voter:     %s`, auth.ValidatorAddress, auth.Voter)
+}
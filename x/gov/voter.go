@@ -0,0 +1,74 @@
+package gov
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetAuthorizedVoter returns the account authorized to vote on behalf of the validator
+func (k Keeper) GetAuthorizedVoter(ctx sdk.Context, valAddr sdk.ValAddress) (voter sdk.AccAddress, found bool) {
+	bz := ctx.KVStore(k.storeKey).Get(VoterKey(valAddr))
+	if bz == nil {
+		return nil, false
+	}
+	return sdk.AccAddress(bz), true
+}
+
+// GetVoterValidator returns the validator which authorized the voter
+func (k Keeper) GetVoterValidator(ctx sdk.Context, voter sdk.AccAddress) (valAddr sdk.ValAddress, found bool) {
+	bz := ctx.KVStore(k.storeKey).Get(VoterValidatorKey(voter))
+	if bz == nil {
+		return nil, false
+	}
+	return sdk.ValAddress(bz), true
+}
+
+// SetAuthorizedVoter authorizes the voter for the validator, replacing the previously authorized voter if any
+func (k Keeper) SetAuthorizedVoter(ctx sdk.Context, valAddr sdk.ValAddress, voter sdk.AccAddress) {
+	k.DeleteAuthorizedVoter(ctx, valAddr)
+	store := ctx.KVStore(k.storeKey)
+	store.Set(VoterKey(valAddr), voter.Bytes())
+	store.Set(VoterValidatorKey(voter), valAddr.Bytes())
+}
+
+// DeleteAuthorizedVoter removes the voter authorized by the validator
+func (k Keeper) DeleteAuthorizedVoter(ctx sdk.Context, valAddr sdk.ValAddress) {
+	voter, found := k.GetAuthorizedVoter(ctx, valAddr)
+	if !found {
+		return
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(VoterKey(valAddr))
+	store.Delete(VoterValidatorKey(voter))
+}
+
+// IterateVoterAuthorizations iterates over all voter authorizations
+func (k Keeper) IterateVoterAuthorizations(ctx sdk.Context, cb func(auth VoterAuthorization) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), VoterKeyPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		valAddr := sdk.ValAddress(iterator.Key()[len(VoterKeyPrefix):])
+		auth := NewVoterAuthorization(valAddr, sdk.AccAddress(iterator.Value()))
+		if cb(auth) {
+			break
+		}
+	}
+}
+
+func (k Keeper) GetVoterAuthorizations(ctx sdk.Context) (auths []VoterAuthorization) {
+	k.IterateVoterAuthorizations(ctx, func(auth VoterAuthorization) bool {
+		auths = append(auths, auth)
+		return false
+	})
+	return auths
+}
+
+// EffectiveVoter returns the address the vote is attributed to, i.e. the operator account of the validator if the
+// voter is authorized by a validator, otherwise the voter itself. The authorization is ignored once the voter creates
+// its own validator, since the voter could not be authorized as a validator in the first place.
+func (k Keeper) EffectiveVoter(ctx sdk.Context, voter sdk.AccAddress) (effectiveVoter sdk.AccAddress, delegated bool) {
+	valAddr, found := k.GetVoterValidator(ctx, voter)
+	if !found || k.isValidator(ctx, voter) {
+		return voter, false
+	}
+	return sdk.AccAddress(valAddr), true
+}
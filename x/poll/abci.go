@@ -0,0 +1,64 @@
+package poll
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// EndBlocker records the final results of the polls ending at the current height, and prunes the polls which have
+// ended for longer than the retention period
+func EndBlocker(ctx sdk.Context, keeper Keeper) {
+	var endedPolls []Poll
+	keeper.IterateEndingPolls(ctx, ctx.BlockHeight(), func(poll Poll) bool {
+		endedPolls = append(endedPolls, poll)
+		return false
+	})
+
+	for _, poll := range endedPolls {
+		result := keeper.Tally(ctx, poll)
+		result.Final = true
+		poll.FinalResult = &result
+		keeper.SetPoll(ctx, poll)
+		keeper.deletePollTally(ctx, poll.ID)
+
+		keeper.Logger(ctx).Info(fmt.Sprintf("poll %d (%s) ended", poll.ID, poll.Title))
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				EventTypeEndPoll,
+				sdk.NewAttribute(AttributeKeyPollID, fmt.Sprintf("%d", poll.ID)),
+			),
+		)
+	}
+
+	prunePolls(ctx, keeper)
+}
+
+// prunePolls deletes the votes of the polls which have ended for longer than the retention period, and then the polls
+// themselves. Each deleted vote or poll counts towards the limit of prunes per block, and the polls not fully pruned
+// are continued in the following blocks.
+func prunePolls(ctx sdk.Context, keeper Keeper) {
+	pruneHeight := ctx.BlockHeight() - keeper.PollRetentionBlocks(ctx)
+	if pruneHeight <= 0 {
+		return
+	}
+	budget := keeper.MaxPrunesPerBlock(ctx)
+	var polls []Poll
+	keeper.IterateEndedPolls(ctx, pruneHeight, func(poll Poll) bool {
+		polls = append(polls, poll)
+		return uint64(len(polls)) >= budget
+	})
+
+	for _, poll := range polls {
+		budget -= keeper.deletePollVotes(ctx, poll.ID, budget)
+		if budget == 0 {
+			return
+		}
+		keeper.deletePoll(ctx, poll)
+		budget--
+		if budget == 0 {
+			return
+		}
+	}
+}
@@ -0,0 +1,88 @@
+package poll
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEndBlockerEndsPolls(t *testing.T) {
+	input, keeper, handler := createTestInput(t)
+	ctx := input.Ctx
+	pollID := createPoll(t, ctx, keeper, handler, addrs[0], WeightModeAccount, 3)
+	laterPollID := createPoll(t, ctx, keeper, handler, addrs[0], WeightModeAccount, 4)
+	vote(t, ctx, handler, pollID, addrs[1], 0)
+	vote(t, ctx, handler, pollID, addrs[2], 2)
+
+	ctx = input.NextBlock(0)
+	EndBlocker(ctx, keeper)
+	poll, _ := keeper.GetPoll(ctx, pollID)
+	require.False(t, poll.IsEnded())
+
+	ctx = input.NextBlock(0)
+	EndBlocker(ctx, keeper)
+	poll, _ = keeper.GetPoll(ctx, pollID)
+	require.True(t, poll.IsEnded())
+	require.True(t, poll.FinalResult.Final)
+	requireTally(t, ctx, keeper, pollID, 1, 0, 1)
+	laterPoll, _ := keeper.GetPoll(ctx, laterPollID)
+	require.False(t, laterPoll.IsEnded())
+	require.Len(t, ctx.EventManager().Events(), 1)
+	require.Equal(t, EventTypeEndPoll, ctx.EventManager().Events()[0].Type)
+}
+
+func TestEndBlockerPrunesPolls(t *testing.T) {
+	input, keeper, handler := createTestInput(t)
+	ctx := input.Ctx
+	setParams(ctx, keeper, func(params *Params) {
+		params.PollRetentionBlocks = 10
+		params.MaxPrunesPerBlock = 3
+	})
+	pollID := createPoll(t, ctx, keeper, handler, addrs[0], WeightModeAccount, 2)
+	laterPollID := createPoll(t, ctx, keeper, handler, addrs[0], WeightModeAccount, 3)
+	for i := 1; i <= 4; i++ {
+		vote(t, ctx, handler, pollID, addrs[i], 0)
+	}
+	vote(t, ctx, handler, laterPollID, addrs[1], 0)
+
+	countVotes := func(pollID uint64) (count int) {
+		keeper.IteratePollVotes(ctx, pollID, func(Vote) bool {
+			count++
+			return false
+		})
+		return count
+	}
+
+	// the poll ends at height 2 and is kept until height 12
+	for ctx.BlockHeight() < 11 {
+		ctx = input.NextBlock(0)
+		EndBlocker(ctx, keeper)
+	}
+	_, found := keeper.GetPoll(ctx, pollID)
+	require.True(t, found)
+	require.Equal(t, 4, countVotes(pollID))
+
+	// the votes are pruned over blocks within the limit, before the poll itself
+	ctx = input.NextBlock(0)
+	EndBlocker(ctx, keeper)
+	_, found = keeper.GetPoll(ctx, pollID)
+	require.True(t, found)
+	require.Equal(t, 1, countVotes(pollID))
+
+	// the remaining budget continues with the next poll
+	ctx = input.NextBlock(0)
+	EndBlocker(ctx, keeper)
+	_, found = keeper.GetPoll(ctx, pollID)
+	require.False(t, found)
+	require.Equal(t, 0, countVotes(pollID))
+	_, found = keeper.GetPoll(ctx, laterPollID)
+	require.True(t, found)
+	require.Equal(t, 0, countVotes(laterPollID))
+
+	ctx = input.NextBlock(0)
+	EndBlocker(ctx, keeper)
+	_, found = keeper.GetPoll(ctx, laterPollID)
+	require.False(t, found)
+	require.Empty(t, keeper.GetPolls(ctx))
+	require.Empty(t, keeper.GetVotes(ctx))
+}
@@ -0,0 +1,82 @@
+package poll
+
+import (
+	"github.com/likecoin/likechain/x/poll/types"
+)
+
+const (
+	ModuleName        = types.ModuleName
+	StoreKey          = types.StoreKey
+	QuerierRoute      = types.QuerierRoute
+	RouterKey         = types.RouterKey
+	QueryParams       = types.QueryParams
+	QueryPolls        = types.QueryPolls
+	QueryPoll         = types.QueryPoll
+	QueryResult       = types.QueryResult
+	WeightModeStake   = types.WeightModeStake
+	WeightModeAccount = types.WeightModeAccount
+)
+
+var (
+	ModuleCdc                   = types.ModuleCdc
+	RegisterCodec               = types.RegisterCodec
+	NewMsgCreatePoll            = types.NewMsgCreatePoll
+	NewMsgVote                  = types.NewMsgVote
+	NewVote                     = types.NewVote
+	NewQueryPollParams          = types.NewQueryPollParams
+	KeyMaxOptions               = types.KeyMaxOptions
+	KeyMaxVotingBlocks          = types.KeyMaxVotingBlocks
+	KeyMaxActivePolls           = types.KeyMaxActivePolls
+	KeyPollRetentionBlocks      = types.KeyPollRetentionBlocks
+	KeyMaxPrunesPerBlock        = types.KeyMaxPrunesPerBlock
+	NewPollResult               = types.NewPollResult
+	DefaultParams               = types.DefaultParams
+	DefaultGenesisState         = types.DefaultGenesisState
+	DefaultCodespace            = types.DefaultCodespace
+	ValidateGenesis             = types.ValidateGenesis
+	PollKey                     = types.PollKey
+	PollKeyPrefix               = types.PollKeyPrefix
+	VoteKey                     = types.VoteKey
+	VoteKeyPrefix               = types.VoteKeyPrefix
+	PollVotesPrefix             = types.PollVotesPrefix
+	ActivePollKey               = types.ActivePollKey
+	ActivePollsPrefix           = types.ActivePollsPrefix
+	ActivePollsByHeightPrefix   = types.ActivePollsByHeightPrefix
+	SplitActivePollKey          = types.SplitActivePollKey
+	NextPollIDKey               = types.NextPollIDKey
+	CreatorActivePollKey        = types.CreatorActivePollKey
+	CreatorActivePollsPrefix    = types.CreatorActivePollsPrefix
+	EndedPollKey                = types.EndedPollKey
+	EndedPollsPrefix            = types.EndedPollsPrefix
+	EndedPollsByHeightPrefix    = types.EndedPollsByHeightPrefix
+	SplitEndedPollKey           = types.SplitEndedPollKey
+	PollTallyKey                = types.PollTallyKey
+	CreatorActivePollsKeyPrefix = types.CreatorActivePollsKeyPrefix
+	ErrInvalidPoll              = types.ErrInvalidPoll
+	ErrUnknownPoll              = types.ErrUnknownPoll
+	ErrPollEnded                = types.ErrPollEnded
+	ErrInvalidOption            = types.ErrInvalidOption
+	ErrTooManyActivePolls       = types.ErrTooManyActivePolls
+	EventTypeCreatePoll         = types.EventTypeCreatePoll
+	EventTypeVotePoll           = types.EventTypeVotePoll
+	EventTypeEndPoll            = types.EventTypeEndPoll
+	AttributeKeyPollID          = types.AttributeKeyPollID
+	AttributeKeyOption          = types.AttributeKeyOption
+	AttributeKeyEndHeight       = types.AttributeKeyEndHeight
+	AttributeValueCategory      = types.AttributeValueCategory
+)
+
+type (
+	MsgCreatePoll   = types.MsgCreatePoll
+	MsgVote         = types.MsgVote
+	Poll            = types.Poll
+	Polls           = types.Polls
+	Vote            = types.Vote
+	OptionResult    = types.OptionResult
+	PollResult      = types.PollResult
+	WeightMode      = types.WeightMode
+	QueryPollParams = types.QueryPollParams
+	Params          = types.Params
+	GenesisState    = types.GenesisState
+	StakingKeeper   = types.StakingKeeper
+)
@@ -0,0 +1,142 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/likecoin/likechain/x/poll/types"
+)
+
+// GetQueryCmd returns the cli query commands for this module
+func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	pollQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the poll module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	pollQueryCmd.AddCommand(client.GetCommands(
+		GetCmdQueryParams(queryRoute, cdc),
+		GetCmdQueryPolls(queryRoute, cdc),
+		GetCmdQueryPoll(queryRoute, cdc),
+		GetCmdQueryResult(queryRoute, cdc),
+	)...)
+
+	return pollQueryCmd
+}
+
+// GetCmdQueryParams implements the poll params query command.
+func GetCmdQueryParams(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "params",
+		Short: "Query the parameters of the poll module",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.Query(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryParams))
+			if err != nil {
+				return err
+			}
+
+			var params types.Params
+			cdc.MustUnmarshalJSON(res, &params)
+			return cliCtx.PrintOutput(params)
+		},
+	}
+}
+
+// GetCmdQueryPolls implements the query all polls command.
+func GetCmdQueryPolls(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "polls",
+		Short: "Query all polls",
+		Long: strings.TrimSpace(`Query all active and ended polls:
+
+$ likecli query poll polls
+`),
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.Query(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryPolls))
+			if err != nil {
+				return err
+			}
+
+			var polls types.Polls
+			cdc.MustUnmarshalJSON(res, &polls)
+			return cliCtx.PrintOutput(polls)
+		},
+	}
+}
+
+func queryPoll(cliCtx context.CLIContext, cdc *codec.Codec, route string, strPollID string) ([]byte, error) {
+	pollID, err := strconv.ParseUint(strPollID, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("poll-id %s not a valid int", strPollID)
+	}
+	bz, err := cdc.MarshalJSON(types.NewQueryPollParams(pollID))
+	if err != nil {
+		return nil, err
+	}
+	res, _, err := cliCtx.QueryWithData(route, bz)
+	return res, err
+}
+
+// GetCmdQueryPoll implements the query poll command.
+func GetCmdQueryPoll(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "poll [poll-id]",
+		Short: "Query the details of a poll",
+		Long: strings.TrimSpace(`Query the details of a poll:
+
+$ likecli query poll poll 1
+`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, err := queryPoll(cliCtx, cdc, fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryPoll), args[0])
+			if err != nil {
+				return err
+			}
+
+			var poll types.Poll
+			cdc.MustUnmarshalJSON(res, &poll)
+			return cliCtx.PrintOutput(poll)
+		},
+	}
+}
+
+// GetCmdQueryResult implements the query poll result command.
+func GetCmdQueryResult(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "result [poll-id]",
+		Short: "Query the result of a poll",
+		Long: strings.TrimSpace(`Query the current result of an active poll, or the final result of an ended poll:
+
+$ likecli query poll result 1
+`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, err := queryPoll(cliCtx, cdc, fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryResult), args[0])
+			if err != nil {
+				return err
+			}
+
+			var result types.PollResult
+			cdc.MustUnmarshalJSON(res, &result)
+			return cliCtx.PrintOutput(result)
+		},
+	}
+}
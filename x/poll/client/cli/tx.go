@@ -0,0 +1,111 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	"github.com/likecoin/likechain/x/poll/types"
+)
+
+const (
+	FlagDescription = "description"
+	FlagWeightMode  = "weight-mode"
+	FlagEndHeight   = "end-height"
+)
+
+// GetTxCmd returns the transaction commands for this module
+func GetTxCmd(storeKey string, cdc *codec.Codec) *cobra.Command {
+	pollTxCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Poll transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	pollTxCmd.AddCommand(client.PostCommands(
+		GetCmdCreatePoll(cdc),
+		GetCmdVote(cdc),
+	)...)
+
+	return pollTxCmd
+}
+
+// GetCmdCreatePoll implements the create poll command
+func GetCmdCreatePoll(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create [title] [option] [option]...",
+		Short: "Create a signalling poll",
+		Long: strings.TrimSpace(`Create a signalling poll with at least 2 options, which accepts votes until the end height. Votes are
+weighted by the bonded tokens of the voters when the poll ends (--weight-mode stake), or counted as one vote
+per account (--weight-mode account). Each account can only have a limited number of polls which have not ended:
+
+$ likecli tx poll create "Next community event" "Meetup" "Hackathon" --end-height 100000 --from mykey
+`),
+		Args: cobra.MinimumNArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			msg := types.NewMsgCreatePoll(
+				cliCtx.GetFromAddress(), args[0], viper.GetString(FlagDescription), args[1:],
+				types.WeightMode(viper.GetString(FlagWeightMode)), viper.GetInt64(FlagEndHeight),
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(FlagDescription, "", "description of the poll")
+	cmd.Flags().String(FlagWeightMode, string(types.WeightModeStake), "how the votes are weighted, stake or account")
+	cmd.Flags().Int64(FlagEndHeight, 0, "the last block height at which the poll accepts votes")
+	cmd.MarkFlagRequired(FlagEndHeight)
+	cmd.MarkFlagRequired(client.FlagFrom)
+
+	return cmd
+}
+
+// GetCmdVote implements the vote on poll command
+func GetCmdVote(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vote [poll-id] [option-index]",
+		Short: "Vote on a poll, or change the vote before the poll ends",
+		Long: strings.TrimSpace(`Vote on an option of a poll, indexed from 0 in the order listed in the poll:
+
+$ likecli tx poll vote 1 0 --from mykey
+`),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			pollID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("poll-id %s not a valid int", args[0])
+			}
+			option, err := strconv.ParseUint(args[1], 10, 32)
+			if err != nil {
+				return fmt.Errorf("option-index %s not a valid int", args[1])
+			}
+
+			msg := types.NewMsgVote(pollID, cliCtx.GetFromAddress(), uint32(option))
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.MarkFlagRequired(client.FlagFrom)
+
+	return cmd
+}
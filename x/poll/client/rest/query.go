@@ -0,0 +1,85 @@
+package rest
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+
+	"github.com/likecoin/likechain/x/poll/types"
+)
+
+func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router) {
+	r.HandleFunc(
+		"/poll/parameters",
+		queryHandlerFn(cliCtx, types.QueryParams),
+	).Methods("GET")
+
+	r.HandleFunc(
+		"/poll/polls",
+		queryHandlerFn(cliCtx, types.QueryPolls),
+	).Methods("GET")
+
+	r.HandleFunc(
+		"/poll/polls/{pollId}",
+		queryPollHandlerFn(cliCtx, types.QueryPoll),
+	).Methods("GET")
+
+	r.HandleFunc(
+		"/poll/polls/{pollId}/result",
+		queryPollHandlerFn(cliCtx, types.QueryResult),
+	).Methods("GET")
+}
+
+func queryHandlerFn(cliCtx context.CLIContext, path string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		res, height, err := cliCtx.Query(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, path))
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+func queryPollHandlerFn(cliCtx context.CLIContext, path string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		strPollID := mux.Vars(r)["pollId"]
+		pollID, err := strconv.ParseUint(strPollID, 10, 64)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("pollId %s not a valid int", strPollID))
+			return
+		}
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		bz, err := cliCtx.Codec.MarshalJSON(types.NewQueryPollParams(pollID))
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, path), bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
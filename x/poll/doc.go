@@ -0,0 +1,14 @@
+// This module implements signalling polls for the community, since only validators can vote on gov proposals.
+// Any account can create a poll with a list of options and an end height, and any account can vote on it. Votes are
+// weighted either by the bonded tokens of the voter or as one vote per account. Polls have no effect on the chain
+// state other than recording their results.
+//
+// Since polls are created without any deposit, each account can only have a limited number of polls which have not
+// ended, and a poll can only last for a limited number of blocks. Voters can vote again to change their option until
+// the poll ends. Polls weighted by account keep a running tally updated by each vote. As in gov, the weight of a stake
+// weighted vote is the tokens the voter delegated to bonded validators when the poll is tallied, so tokens unbonded
+// after voting are not counted again for another voter, and ending such a poll iterates the delegations of its voters.
+// Ended polls stay queryable for a retention period, after which they are pruned with their votes, deleting a limited
+// number of polls and votes in each block.
+
+package poll
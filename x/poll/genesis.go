@@ -0,0 +1,42 @@
+package poll
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+func InitGenesis(ctx sdk.Context, keeper Keeper, genesisState GenesisState) []abci.ValidatorUpdate {
+	keeper.SetParams(ctx, genesisState.Params)
+	nextPollID := uint64(1)
+	for _, poll := range genesisState.Polls {
+		keeper.SetPoll(ctx, poll)
+		if poll.ID >= nextPollID {
+			nextPollID = poll.ID + 1
+		}
+	}
+	keeper.SetNextPollID(ctx, nextPollID)
+	// the running tallies of the active polls weighted by account are rebuilt from their votes
+	polls := make(map[uint64]Poll)
+	for _, poll := range genesisState.Polls {
+		polls[poll.ID] = poll
+	}
+	for _, vote := range genesisState.Votes {
+		if poll := polls[vote.PollID]; poll.IsEnded() {
+			keeper.SetVote(ctx, vote)
+		} else {
+			keeper.AddVote(ctx, poll, vote)
+		}
+	}
+	return nil
+}
+
+func ExportGenesis(ctx sdk.Context, keeper Keeper) GenesisState {
+	params := keeper.GetParams(ctx)
+	polls := keeper.GetPolls(ctx)
+	votes := keeper.GetVotes(ctx)
+	return GenesisState{
+		Params: params,
+		Polls:  polls,
+		Votes:  votes,
+	}
+}
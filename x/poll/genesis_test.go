@@ -0,0 +1,61 @@
+package poll
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenesisRoundTrip(t *testing.T) {
+	input, keeper, handler := createTestInput(t)
+	ctx := input.Ctx
+	endedPollID := createPoll(t, ctx, keeper, handler, addrs[0], WeightModeAccount, 2)
+	activePollID := createPoll(t, ctx, keeper, handler, addrs[0], WeightModeAccount, 10)
+	vote(t, ctx, handler, endedPollID, addrs[1], 0)
+	vote(t, ctx, handler, activePollID, addrs[1], 1)
+	vote(t, ctx, handler, activePollID, addrs[2], 2)
+	ctx = input.NextBlock(0)
+	EndBlocker(ctx, keeper)
+
+	genesisState := ExportGenesis(ctx, keeper)
+	require.NoError(t, ValidateGenesis(genesisState))
+	require.Len(t, genesisState.Polls, 2)
+	require.Len(t, genesisState.Votes, 3)
+
+	input2, keeper2, handler2 := createTestInput(t)
+	ctx2 := input2.Ctx.WithBlockHeight(ctx.BlockHeight())
+	InitGenesis(ctx2, keeper2, genesisState)
+	require.Equal(t, genesisState, ExportGenesis(ctx2, keeper2))
+	require.Equal(t, keeper.GetNextPollID(ctx), keeper2.GetNextPollID(ctx2))
+	require.Equal(t, uint64(1), keeper2.CountActivePolls(ctx2, addrs[0]))
+	requireTally(t, ctx2, keeper2, endedPollID, 1, 0, 0)
+	requireTally(t, ctx2, keeper2, activePollID, 0, 1, 1)
+
+	// the running tally of the active poll is rebuilt
+	vote(t, ctx2, handler2, activePollID, addrs[2], 1)
+	requireTally(t, ctx2, keeper2, activePollID, 0, 2, 0)
+}
+
+func TestValidateGenesis(t *testing.T) {
+	poll := Poll{ID: 1, Creator: addrs[0], Title: "title", Options: options, WeightMode: WeightModeAccount, EndHeight: 10}
+	tests := []struct {
+		name  string
+		votes []Vote
+		valid bool
+	}{
+		{"valid vote", []Vote{NewVote(1, addrs[1], 0)}, true},
+		{"unknown poll", []Vote{NewVote(2, addrs[1], 0)}, false},
+		{"invalid option", []Vote{NewVote(1, addrs[1], 3)}, false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			genesisState := GenesisState{Params: DefaultParams(), Polls: Polls{poll}, Votes: test.votes}
+			err := ValidateGenesis(genesisState)
+			if test.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
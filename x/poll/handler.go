@@ -0,0 +1,100 @@
+package poll
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func NewHandler(keeper Keeper) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+		switch msg := msg.(type) {
+		case MsgCreatePoll:
+			return handleMsgCreatePoll(ctx, msg, keeper)
+		case MsgVote:
+			return handleMsgVote(ctx, msg, keeper)
+		default:
+			errMsg := fmt.Sprintf("unrecognized poll message type: %T", msg)
+			return sdk.ErrUnknownRequest(errMsg).Result()
+		}
+	}
+}
+
+func handleMsgCreatePoll(ctx sdk.Context, msg MsgCreatePoll, keeper Keeper) sdk.Result {
+	maxOptions := keeper.MaxOptions(ctx)
+	if uint64(len(msg.Options)) > maxOptions {
+		return ErrInvalidPoll(keeper.Codespace(), fmt.Sprintf("poll can have at most %d options", maxOptions)).Result()
+	}
+	height := ctx.BlockHeight()
+	if msg.EndHeight <= height {
+		return ErrInvalidPoll(keeper.Codespace(), fmt.Sprintf("end height must be after the current height %d", height)).Result()
+	}
+	maxVotingBlocks := keeper.MaxVotingBlocks(ctx)
+	if msg.EndHeight-height > maxVotingBlocks {
+		return ErrInvalidPoll(keeper.Codespace(), fmt.Sprintf("poll can last for at most %d blocks", maxVotingBlocks)).Result()
+	}
+	maxActivePolls := keeper.MaxActivePolls(ctx)
+	if keeper.CountActivePolls(ctx, msg.Creator) >= maxActivePolls {
+		return ErrTooManyActivePolls(keeper.Codespace(), maxActivePolls).Result()
+	}
+
+	pollID := keeper.GetNextPollID(ctx)
+	keeper.SetNextPollID(ctx, pollID+1)
+	keeper.SetPoll(ctx, Poll{
+		ID:          pollID,
+		Creator:     msg.Creator,
+		Title:       msg.Title,
+		Description: msg.Description,
+		Options:     msg.Options,
+		WeightMode:  msg.WeightMode,
+		EndHeight:   msg.EndHeight,
+	})
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			EventTypeCreatePoll,
+			sdk.NewAttribute(AttributeKeyPollID, fmt.Sprintf("%d", pollID)),
+			sdk.NewAttribute(AttributeKeyEndHeight, fmt.Sprintf("%d", msg.EndHeight)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Creator.String()),
+		),
+	})
+
+	return sdk.Result{
+		Data:   keeper.cdc.MustMarshalBinaryLengthPrefixed(pollID),
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+func handleMsgVote(ctx sdk.Context, msg MsgVote, keeper Keeper) sdk.Result {
+	poll, found := keeper.GetPoll(ctx, msg.PollID)
+	if !found {
+		return ErrUnknownPoll(keeper.Codespace(), msg.PollID).Result()
+	}
+	if poll.IsEnded() {
+		return ErrPollEnded(keeper.Codespace(), msg.PollID).Result()
+	}
+	if int(msg.Option) >= len(poll.Options) {
+		return ErrInvalidOption(keeper.Codespace(), msg.Option).Result()
+	}
+	keeper.AddVote(ctx, poll, NewVote(msg.PollID, msg.Voter, msg.Option))
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			EventTypeVotePoll,
+			sdk.NewAttribute(AttributeKeyPollID, fmt.Sprintf("%d", msg.PollID)),
+			sdk.NewAttribute(AttributeKeyOption, poll.Options[msg.Option]),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Voter.String()),
+		),
+	})
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
@@ -0,0 +1,137 @@
+package poll
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+
+	"github.com/likecoin/likechain/x/poll/types"
+)
+
+func TestCreatePoll(t *testing.T) {
+	input, keeper, handler := createTestInput(t)
+	ctx := input.Ctx
+
+	pollID := createPoll(t, ctx, keeper, handler, addrs[0], WeightModeAccount, 10)
+	require.Equal(t, uint64(1), pollID)
+	require.Equal(t, uint64(2), keeper.GetNextPollID(ctx))
+	poll, found := keeper.GetPoll(ctx, pollID)
+	require.True(t, found)
+	require.Equal(t, addrs[0], poll.Creator)
+	require.Equal(t, options, poll.Options)
+	require.False(t, poll.IsEnded())
+	requireTally(t, ctx, keeper, pollID, 0, 0, 0)
+
+	maxVotingBlocks := keeper.MaxVotingBlocks(ctx)
+	tooManyOptions := make([]string, keeper.MaxOptions(ctx)+1)
+	for i := range tooManyOptions {
+		tooManyOptions[i] = "option"
+	}
+	tests := []struct {
+		name string
+		msg  MsgCreatePoll
+	}{
+		{"too many options", NewMsgCreatePoll(addrs[1], "title", "", tooManyOptions, WeightModeAccount, 10)},
+		{"end height not after current height", NewMsgCreatePoll(addrs[1], "title", "", options, WeightModeAccount, ctx.BlockHeight())},
+		{"voting period too long", NewMsgCreatePoll(addrs[1], "title", "", options, WeightModeAccount, ctx.BlockHeight()+maxVotingBlocks+1)},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result := handler(ctx, test.msg)
+			require.False(t, result.IsOK())
+			require.Equal(t, types.CodeInvalidPoll, result.Code, result.Log)
+		})
+	}
+	createPoll(t, ctx, keeper, handler, addrs[1], WeightModeAccount, ctx.BlockHeight()+maxVotingBlocks)
+}
+
+func TestMaxActivePolls(t *testing.T) {
+	input, keeper, handler := createTestInput(t)
+	ctx := input.Ctx
+	setParams(ctx, keeper, func(params *Params) {
+		params.MaxActivePolls = 2
+	})
+
+	createPoll(t, ctx, keeper, handler, addrs[0], WeightModeAccount, 2)
+	createPoll(t, ctx, keeper, handler, addrs[0], WeightModeAccount, 10)
+	require.Equal(t, uint64(2), keeper.CountActivePolls(ctx, addrs[0]))
+	result := handler(ctx, NewMsgCreatePoll(addrs[0], "title", "", options, WeightModeAccount, 10))
+	require.False(t, result.IsOK())
+	require.Equal(t, types.CodeTooManyPolls, result.Code, result.Log)
+
+	// the limit is per creator
+	createPoll(t, ctx, keeper, handler, addrs[1], WeightModeAccount, 10)
+
+	// ended polls no longer count towards the limit
+	ctx = input.NextBlock(0)
+	EndBlocker(ctx, keeper)
+	require.Equal(t, uint64(1), keeper.CountActivePolls(ctx, addrs[0]))
+	createPoll(t, ctx, keeper, handler, addrs[0], WeightModeAccount, 10)
+}
+
+func TestVotePoll(t *testing.T) {
+	input, keeper, handler := createTestInput(t)
+	ctx := input.Ctx
+	pollID := createPoll(t, ctx, keeper, handler, addrs[0], WeightModeAccount, 2)
+
+	result := handler(ctx, NewMsgVote(pollID+1, addrs[1], 0))
+	require.Equal(t, types.CodeUnknownPoll, result.Code, result.Log)
+	result = handler(ctx, NewMsgVote(pollID, addrs[1], uint32(len(options))))
+	require.Equal(t, types.CodeInvalidOption, result.Code, result.Log)
+
+	vote(t, ctx, handler, pollID, addrs[1], 0)
+	vote(t, ctx, handler, pollID, addrs[2], 0)
+	requireTally(t, ctx, keeper, pollID, 2, 0, 0)
+
+	// voting again replaces the previous vote in the tally
+	vote(t, ctx, handler, pollID, addrs[1], 1)
+	requireTally(t, ctx, keeper, pollID, 1, 1, 0)
+	v, found := keeper.GetVote(ctx, pollID, addrs[1])
+	require.True(t, found)
+	require.Equal(t, uint32(1), v.Option)
+
+	ctx = input.NextBlock(0)
+	EndBlocker(ctx, keeper)
+	result = handler(ctx, NewMsgVote(pollID, addrs[3], 0))
+	require.Equal(t, types.CodePollEnded, result.Code, result.Log)
+	requireTally(t, ctx, keeper, pollID, 1, 1, 0)
+}
+
+func TestVotePollByStake(t *testing.T) {
+	input, keeper, handler := createTestInput(t)
+	valAddr := input.CreateValidator(t, 0, 10)
+	input.Delegate(t, 1, valAddr, 5)
+	ctx := input.Ctx
+	pollID := createPoll(t, ctx, keeper, handler, addrs[0], WeightModeStake, 10)
+
+	vote(t, ctx, handler, pollID, addrs[0], 0)
+	vote(t, ctx, handler, pollID, addrs[1], 1)
+	// accounts without bonded delegations vote with zero weight
+	vote(t, ctx, handler, pollID, addrs[2], 2)
+	power := sdk.TokensFromConsensusPower(1).Int64()
+	requireTally(t, ctx, keeper, pollID, 10*power, 5*power, 0)
+
+	// the weights follow the delegations until the poll is tallied
+	input.Delegate(t, 1, valAddr, 5)
+	requireTally(t, ctx, keeper, pollID, 10*power, 10*power, 0)
+
+	// unbonded tokens are no longer counted for the voter, so they are counted once if they are delegated again by
+	// another voter
+	undelegate := staking.NewMsgUndelegate(
+		addrs[1], valAddr, sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(10)),
+	)
+	result := staking.NewHandler(input.StakingKeeper)(ctx, undelegate)
+	require.True(t, result.IsOK(), result.Log)
+	input.Delegate(t, 2, valAddr, 10)
+	requireTally(t, ctx, keeper, pollID, 10*power, 0, 10*power)
+
+	for ctx.BlockHeight() < 10 {
+		ctx = input.NextBlock(0)
+	}
+	EndBlocker(ctx, keeper)
+	input.Delegate(t, 1, valAddr, 5)
+	requireTally(t, ctx, keeper, pollID, 10*power, 0, 10*power)
+}
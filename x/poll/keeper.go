@@ -0,0 +1,312 @@
+package poll
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/cosmos/cosmos-sdk/x/staking/exported"
+)
+
+const (
+	DefaultParamspace = ModuleName
+)
+
+type Keeper struct {
+	storeKey      sdk.StoreKey
+	cdc           *codec.Codec
+	paramstore    params.Subspace
+	stakingKeeper StakingKeeper
+	codespace     sdk.CodespaceType
+}
+
+func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, paramstore params.Subspace, stakingKeeper StakingKeeper,
+	codespace sdk.CodespaceType) Keeper {
+	return Keeper{
+		storeKey:      key,
+		cdc:           cdc,
+		paramstore:    paramstore.WithKeyTable(ParamKeyTable()),
+		stakingKeeper: stakingKeeper,
+		codespace:     codespace,
+	}
+}
+
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", ModuleName))
+}
+
+func (k Keeper) Codespace() sdk.CodespaceType {
+	return k.codespace
+}
+
+func ParamKeyTable() params.KeyTable {
+	return params.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+func (k Keeper) MaxOptions(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, KeyMaxOptions, &res)
+	return
+}
+
+func (k Keeper) MaxVotingBlocks(ctx sdk.Context) (res int64) {
+	k.paramstore.Get(ctx, KeyMaxVotingBlocks, &res)
+	return
+}
+
+func (k Keeper) MaxActivePolls(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, KeyMaxActivePolls, &res)
+	return
+}
+
+func (k Keeper) PollRetentionBlocks(ctx sdk.Context) (res int64) {
+	k.paramstore.Get(ctx, KeyPollRetentionBlocks, &res)
+	return
+}
+
+func (k Keeper) MaxPrunesPerBlock(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, KeyMaxPrunesPerBlock, &res)
+	return
+}
+
+func (k Keeper) GetParams(ctx sdk.Context) Params {
+	return Params{
+		MaxOptions:          k.MaxOptions(ctx),
+		MaxVotingBlocks:     k.MaxVotingBlocks(ctx),
+		MaxActivePolls:      k.MaxActivePolls(ctx),
+		PollRetentionBlocks: k.PollRetentionBlocks(ctx),
+		MaxPrunesPerBlock:   k.MaxPrunesPerBlock(ctx),
+	}
+}
+
+func (k Keeper) SetParams(ctx sdk.Context, params Params) {
+	k.paramstore.SetParamSet(ctx, &params)
+}
+
+func (k Keeper) GetNextPollID(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(NextPollIDKey)
+	if bz == nil {
+		return 1
+	}
+	var pollID uint64
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &pollID)
+	return pollID
+}
+
+func (k Keeper) SetNextPollID(ctx sdk.Context, pollID uint64) {
+	ctx.KVStore(k.storeKey).Set(NextPollIDKey, k.cdc.MustMarshalBinaryLengthPrefixed(pollID))
+}
+
+func (k Keeper) GetPoll(ctx sdk.Context, pollID uint64) (poll Poll, found bool) {
+	bz := ctx.KVStore(k.storeKey).Get(PollKey(pollID))
+	if bz == nil {
+		return poll, false
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &poll)
+	return poll, true
+}
+
+// SetPoll stores the poll, and keeps it in the active poll queue and the index by creator until it ends, after which
+// it is moved to the ended poll queue for pruning
+func (k Keeper) SetPoll(ctx sdk.Context, poll Poll) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(PollKey(poll.ID), k.cdc.MustMarshalBinaryLengthPrefixed(poll))
+	if poll.IsEnded() {
+		store.Delete(ActivePollKey(poll.EndHeight, poll.ID))
+		store.Delete(CreatorActivePollKey(poll.Creator, poll.ID))
+		store.Set(EndedPollKey(poll.EndHeight, poll.ID), []byte{1})
+	} else {
+		store.Set(ActivePollKey(poll.EndHeight, poll.ID), []byte{1})
+		store.Set(CreatorActivePollKey(poll.Creator, poll.ID), []byte{1})
+	}
+}
+
+// deletePoll removes an ended poll, after its votes are removed
+func (k Keeper) deletePoll(ctx sdk.Context, poll Poll) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(PollKey(poll.ID))
+	store.Delete(EndedPollKey(poll.EndHeight, poll.ID))
+}
+
+// CountActivePolls counts the polls created by the creator which have not yet ended
+func (k Keeper) CountActivePolls(ctx sdk.Context, creator sdk.AccAddress) (count uint64) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), CreatorActivePollsKeyPrefix(creator))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		count++
+	}
+	return count
+}
+
+func (k Keeper) IteratePolls(ctx sdk.Context, cb func(poll Poll) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), PollKeyPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var poll Poll
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &poll)
+		if cb(poll) {
+			break
+		}
+	}
+}
+
+func (k Keeper) GetPolls(ctx sdk.Context) (polls Polls) {
+	k.IteratePolls(ctx, func(poll Poll) bool {
+		polls = append(polls, poll)
+		return false
+	})
+	return polls
+}
+
+// IterateEndingPolls iterates over the active polls whose end height is not after the given height
+func (k Keeper) IterateEndingPolls(ctx sdk.Context, height int64, cb func(poll Poll) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(ActivePollsPrefix, ActivePollsByHeightPrefix(height+1))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		poll, found := k.GetPoll(ctx, SplitActivePollKey(iterator.Key()))
+		if !found {
+			panic(fmt.Sprintf("poll %d in active poll queue does not exist", SplitActivePollKey(iterator.Key())))
+		}
+		if cb(poll) {
+			break
+		}
+	}
+}
+
+// IterateEndedPolls iterates over the ended polls whose end height is not after the given height
+func (k Keeper) IterateEndedPolls(ctx sdk.Context, height int64, cb func(poll Poll) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(EndedPollsPrefix, EndedPollsByHeightPrefix(height+1))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		poll, found := k.GetPoll(ctx, SplitEndedPollKey(iterator.Key()))
+		if !found {
+			panic(fmt.Sprintf("poll %d in ended poll queue does not exist", SplitEndedPollKey(iterator.Key())))
+		}
+		if cb(poll) {
+			break
+		}
+	}
+}
+
+func (k Keeper) GetVote(ctx sdk.Context, pollID uint64, voter sdk.AccAddress) (vote Vote, found bool) {
+	bz := ctx.KVStore(k.storeKey).Get(VoteKey(pollID, voter))
+	if bz == nil {
+		return vote, false
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &vote)
+	return vote, true
+}
+
+func (k Keeper) SetVote(ctx sdk.Context, vote Vote) {
+	ctx.KVStore(k.storeKey).Set(VoteKey(vote.PollID, vote.Voter), k.cdc.MustMarshalBinaryLengthPrefixed(vote))
+}
+
+func (k Keeper) IteratePollVotes(ctx sdk.Context, pollID uint64, cb func(vote Vote) (stop bool)) {
+	k.iterateVotes(ctx, PollVotesPrefix(pollID), cb)
+}
+
+func (k Keeper) IterateVotes(ctx sdk.Context, cb func(vote Vote) (stop bool)) {
+	k.iterateVotes(ctx, VoteKeyPrefix, cb)
+}
+
+func (k Keeper) iterateVotes(ctx sdk.Context, prefix []byte, cb func(vote Vote) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), prefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var vote Vote
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &vote)
+		if cb(vote) {
+			break
+		}
+	}
+}
+
+func (k Keeper) GetVotes(ctx sdk.Context) (votes []Vote) {
+	k.IterateVotes(ctx, func(vote Vote) bool {
+		votes = append(votes, vote)
+		return false
+	})
+	return votes
+}
+
+// deletePollVotes removes at most limit votes of the poll, and returns the number of votes removed
+func (k Keeper) deletePollVotes(ctx sdk.Context, pollID uint64, limit uint64) (count uint64) {
+	store := ctx.KVStore(k.storeKey)
+	var keys [][]byte
+	iterator := sdk.KVStorePrefixIterator(store, PollVotesPrefix(pollID))
+	for ; iterator.Valid() && uint64(len(keys)) < limit; iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+	for _, key := range keys {
+		store.Delete(key)
+	}
+	return uint64(len(keys))
+}
+
+func (k Keeper) getPollTally(ctx sdk.Context, poll Poll) PollResult {
+	bz := ctx.KVStore(k.storeKey).Get(PollTallyKey(poll.ID))
+	if bz == nil {
+		return NewPollResult(poll)
+	}
+	var result PollResult
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &result)
+	return result
+}
+
+func (k Keeper) setPollTally(ctx sdk.Context, result PollResult) {
+	ctx.KVStore(k.storeKey).Set(PollTallyKey(result.PollID), k.cdc.MustMarshalBinaryLengthPrefixed(result))
+}
+
+func (k Keeper) deletePollTally(ctx sdk.Context, pollID uint64) {
+	ctx.KVStore(k.storeKey).Delete(PollTallyKey(pollID))
+}
+
+// stakeWeight returns the tokens the voter delegated to bonded validators
+func (k Keeper) stakeWeight(ctx sdk.Context, voter sdk.AccAddress) sdk.Dec {
+	weight := sdk.ZeroDec()
+	k.stakingKeeper.IterateDelegations(ctx, voter, func(index int64, delegation exported.DelegationI) (stop bool) {
+		validator := k.stakingKeeper.Validator(ctx, delegation.GetValidatorAddr())
+		if validator != nil && validator.IsBonded() {
+			weight = weight.Add(validator.TokensFromShares(delegation.GetShares()))
+		}
+		return false
+	})
+	return weight
+}
+
+// AddVote stores the vote on the active poll, replacing the previous vote of the voter if any. Polls weighted by
+// account keep a running tally updated by each vote, while the stake weights are only known when tallying.
+func (k Keeper) AddVote(ctx sdk.Context, poll Poll, vote Vote) {
+	if poll.WeightMode == WeightModeAccount {
+		result := k.getPollTally(ctx, poll)
+		if oldVote, found := k.GetVote(ctx, poll.ID, vote.Voter); found {
+			result.RemoveVote(oldVote.Option, sdk.OneDec())
+		}
+		result.AddVote(vote.Option, sdk.OneDec())
+		k.setPollTally(ctx, result)
+	}
+	k.SetVote(ctx, vote)
+}
+
+// Tally returns the current result of the poll, or the final result if the poll has ended. In stake mode, each vote
+// is weighted by the tokens the voter delegated to bonded validators at the time of tallying as in gov, so tokens
+// unbonded after voting and delegated again by another voter are only counted once.
+func (k Keeper) Tally(ctx sdk.Context, poll Poll) PollResult {
+	if poll.IsEnded() {
+		return *poll.FinalResult
+	}
+	if poll.WeightMode != WeightModeStake {
+		return k.getPollTally(ctx, poll)
+	}
+	result := NewPollResult(poll)
+	k.IteratePollVotes(ctx, poll.ID, func(vote Vote) bool {
+		result.AddVote(vote.Option, k.stakeWeight(ctx, vote.Voter))
+		return false
+	})
+	return result
+}
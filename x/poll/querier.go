@@ -0,0 +1,90 @@
+package poll
+
+import (
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func NewQuerier(k Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) (res []byte, err sdk.Error) {
+		switch path[0] {
+		case QueryParams:
+			return queryParams(ctx, req, k)
+		case QueryPolls:
+			return queryPolls(ctx, req, k)
+		case QueryPoll:
+			return queryPoll(ctx, req, k)
+		case QueryResult:
+			return queryResult(ctx, req, k)
+		default:
+			return nil, sdk.ErrUnknownRequest("unknown poll query endpoint")
+		}
+	}
+}
+
+func queryParams(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	res, err := codec.MarshalJSONIndent(ModuleCdc, k.GetParams(ctx))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to JSON marshal result: %s", err.Error()))
+	}
+
+	return res, nil
+}
+
+func queryPolls(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	polls := k.GetPolls(ctx)
+	if polls == nil {
+		polls = Polls{}
+	}
+
+	res, err := codec.MarshalJSONIndent(ModuleCdc, polls)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to JSON marshal result: %s", err.Error()))
+	}
+
+	return res, nil
+}
+
+func getQueriedPoll(ctx sdk.Context, req abci.RequestQuery, k Keeper) (Poll, sdk.Error) {
+	var params QueryPollParams
+	err := ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return Poll{}, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	poll, found := k.GetPoll(ctx, params.PollID)
+	if !found {
+		return Poll{}, ErrUnknownPoll(k.Codespace(), params.PollID)
+	}
+	return poll, nil
+}
+
+func queryPoll(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	poll, sdkErr := getQueriedPoll(ctx, req, k)
+	if sdkErr != nil {
+		return nil, sdkErr
+	}
+
+	res, err := codec.MarshalJSONIndent(ModuleCdc, poll)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to JSON marshal result: %s", err.Error()))
+	}
+
+	return res, nil
+}
+
+func queryResult(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	poll, sdkErr := getQueriedPoll(ctx, req, k)
+	if sdkErr != nil {
+		return nil, sdkErr
+	}
+
+	res, err := codec.MarshalJSONIndent(ModuleCdc, k.Tally(ctx, poll))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to JSON marshal result: %s", err.Error()))
+	}
+
+	return res, nil
+}
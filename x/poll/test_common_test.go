@@ -0,0 +1,220 @@
+package poll
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/libs/log"
+	tmtypes "github.com/tendermint/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+)
+
+// dummy accounts used in tests, each funded with initTokens
+var (
+	pubKeys = createTestPubKeys(100)
+	addrs   = createTestAddrs(pubKeys)
+
+	initTokens = sdk.TokensFromConsensusPower(1000)
+)
+
+func createTestPubKeys(n int) []crypto.PubKey {
+	pubKeys := make([]crypto.PubKey, n)
+	for i := range pubKeys {
+		var secret [8]byte
+		secret[0], secret[1] = byte(i>>8), byte(i)
+		pubKeys[i] = ed25519.GenPrivKeyFromSecret(secret[:]).PubKey()
+	}
+	return pubKeys
+}
+
+func createTestAddrs(pubKeys []crypto.PubKey) []sdk.AccAddress {
+	addrs := make([]sdk.AccAddress, len(pubKeys))
+	for i, pubKey := range pubKeys {
+		addrs[i] = sdk.AccAddress(pubKey.Address())
+	}
+	return addrs
+}
+
+func makeTestCodec() *codec.Codec {
+	cdc := codec.New()
+	sdk.RegisterCodec(cdc)
+	codec.RegisterCrypto(cdc)
+	auth.RegisterCodec(cdc)
+	bank.RegisterCodec(cdc)
+	staking.RegisterCodec(cdc)
+	supply.RegisterCodec(cdc)
+	RegisterCodec(cdc)
+	return cdc
+}
+
+// testInput holds the context and the keepers sharing the same multistore
+type testInput struct {
+	Ctx sdk.Context
+	Cdc *codec.Codec
+
+	StakingKeeper staking.Keeper
+}
+
+// createTestInput returns the input with the poll keeper using the default params, and the poll handler
+func createTestInput(t testing.TB) (*testInput, Keeper, sdk.Handler) {
+	keys := sdk.NewKVStoreKeys(
+		auth.StoreKey, params.StoreKey, supply.StoreKey, staking.StoreKey, StoreKey,
+	)
+	tkeys := sdk.NewTransientStoreKeys(params.TStoreKey, staking.TStoreKey)
+
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+	for _, key := range keys {
+		ms.MountStoreWithDB(key, sdk.StoreTypeIAVL, db)
+	}
+	for _, tkey := range tkeys {
+		ms.MountStoreWithDB(tkey, sdk.StoreTypeTransient, db)
+	}
+	err := ms.LoadLatestVersion()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	header := abci.Header{ChainID: "likechain-test", Height: 1, Time: time.Unix(1500000000, 0).UTC()}
+	ctx := sdk.NewContext(ms, header, false, log.NewNopLogger())
+	ctx = ctx.WithConsensusParams(&abci.ConsensusParams{
+		Validator: &abci.ValidatorParams{PubKeyTypes: []string{tmtypes.ABCIPubKeyTypeEd25519}},
+	})
+	cdc := makeTestCodec()
+
+	maccPerms := map[string][]string{
+		staking.BondedPoolName:    {supply.Burner, supply.Staking},
+		staking.NotBondedPoolName: {supply.Burner, supply.Staking},
+	}
+	blacklistedAddrs := map[string]bool{}
+	for name := range maccPerms {
+		blacklistedAddrs[supply.NewModuleAddress(name).String()] = true
+	}
+
+	paramsKeeper := params.NewKeeper(cdc, keys[params.StoreKey], tkeys[params.TStoreKey], params.DefaultCodespace)
+	accountKeeper := auth.NewAccountKeeper(cdc, keys[auth.StoreKey], paramsKeeper.Subspace(auth.DefaultParamspace),
+		auth.ProtoBaseAccount)
+	bankKeeper := bank.NewBaseKeeper(accountKeeper, paramsKeeper.Subspace(bank.DefaultParamspace), bank.DefaultCodespace,
+		blacklistedAddrs)
+	supplyKeeper := supply.NewKeeper(cdc, keys[supply.StoreKey], accountKeeper, bankKeeper, maccPerms)
+	stakingKeeper := staking.NewKeeper(cdc, keys[staking.StoreKey], tkeys[staking.TStoreKey], supplyKeeper,
+		paramsKeeper.Subspace(staking.DefaultParamspace), staking.DefaultCodespace)
+	keeper := NewKeeper(
+		cdc, keys[StoreKey], paramsKeeper.Subspace(DefaultParamspace), stakingKeeper, DefaultCodespace,
+	)
+
+	bankKeeper.SetSendEnabled(ctx, true)
+	stakingKeeper.SetParams(ctx, staking.DefaultParams())
+	totalSupply := sdk.NewCoins()
+	for _, addr := range addrs {
+		coins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, initTokens))
+		_, err := bankKeeper.AddCoins(ctx, addr, coins)
+		if err != nil {
+			t.Fatal(err)
+		}
+		totalSupply = totalSupply.Add(coins)
+	}
+	supplyKeeper.SetSupply(ctx, supply.NewSupply(totalSupply))
+	for name, perms := range maccPerms {
+		supplyKeeper.SetModuleAccount(ctx, supply.NewEmptyModuleAccount(name, perms...))
+	}
+	InitGenesis(ctx, keeper, DefaultGenesisState())
+
+	input := &testInput{
+		Ctx:           ctx,
+		Cdc:           cdc,
+		StakingKeeper: stakingKeeper,
+	}
+	return input, keeper, NewHandler(keeper)
+}
+
+// CreateValidator creates a validator operated by addrs[i] with the self-delegation of the given consensus power, and
+// applies the validator set updates so that the validator is bonded
+func (input *testInput) CreateValidator(t testing.TB, i int, power int64) sdk.ValAddress {
+	valAddr := sdk.ValAddress(addrs[i])
+	tokens := sdk.TokensFromConsensusPower(power)
+	msg := staking.NewMsgCreateValidator(
+		valAddr, pubKeys[i], sdk.NewCoin(sdk.DefaultBondDenom, tokens),
+		staking.NewDescription(fmt.Sprintf("validator-%d", i), "", "", ""),
+		staking.NewCommissionRates(sdk.ZeroDec(), sdk.OneDec(), sdk.ZeroDec()), sdk.OneInt(),
+	)
+	result := staking.NewHandler(input.StakingKeeper)(input.Ctx, msg)
+	if !result.IsOK() {
+		t.Fatalf("cannot create validator %d: %s", i, result.Log)
+	}
+	staking.EndBlocker(input.Ctx, input.StakingKeeper)
+	return valAddr
+}
+
+// Delegate delegates the tokens of the given consensus power from addrs[i] to the validator
+func (input *testInput) Delegate(t testing.TB, i int, valAddr sdk.ValAddress, power int64) {
+	tokens := sdk.TokensFromConsensusPower(power)
+	msg := staking.NewMsgDelegate(addrs[i], valAddr, sdk.NewCoin(sdk.DefaultBondDenom, tokens))
+	result := staking.NewHandler(input.StakingKeeper)(input.Ctx, msg)
+	if !result.IsOK() {
+		t.Fatalf("cannot delegate from %d: %s", i, result.Log)
+	}
+	staking.EndBlocker(input.Ctx, input.StakingKeeper)
+}
+
+// NextBlock returns the context of the next block, the given duration after the current block
+func (input *testInput) NextBlock(d time.Duration) sdk.Context {
+	header := input.Ctx.BlockHeader()
+	header.Height++
+	header.Time = header.Time.Add(d)
+	input.Ctx = input.Ctx.WithBlockHeader(header)
+	return input.Ctx
+}
+
+var options = []string{"yes", "no", "abstain"}
+
+func setParams(ctx sdk.Context, keeper Keeper, update func(params *Params)) {
+	params := keeper.GetParams(ctx)
+	update(&params)
+	keeper.SetParams(ctx, params)
+}
+
+// createPoll creates a poll with the test options from the creator, and returns the poll ID
+func createPoll(
+	t *testing.T, ctx sdk.Context, keeper Keeper, handler sdk.Handler, creator sdk.AccAddress, weightMode WeightMode,
+	endHeight int64,
+) uint64 {
+	msg := NewMsgCreatePoll(creator, "title", "description", options, weightMode, endHeight)
+	result := handler(ctx, msg)
+	require.True(t, result.IsOK(), result.Log)
+	var pollID uint64
+	keeper.cdc.MustUnmarshalBinaryLengthPrefixed(result.Data, &pollID)
+	return pollID
+}
+
+func vote(t *testing.T, ctx sdk.Context, handler sdk.Handler, pollID uint64, voter sdk.AccAddress, option uint32) {
+	result := handler(ctx, NewMsgVote(pollID, voter, option))
+	require.True(t, result.IsOK(), result.Log)
+}
+
+func requireTally(t *testing.T, ctx sdk.Context, keeper Keeper, pollID uint64, weights ...int64) {
+	poll, found := keeper.GetPoll(ctx, pollID)
+	require.True(t, found)
+	result := keeper.Tally(ctx, poll)
+	total := sdk.ZeroDec()
+	for i, weight := range weights {
+		require.Equal(t, sdk.NewDec(weight), result.Results[i].Weight, "option %d", i)
+		total = total.Add(sdk.NewDec(weight))
+	}
+	require.Equal(t, total, result.TotalWeight)
+}
@@ -0,0 +1,37 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	DefaultCodespace sdk.CodespaceType = ModuleName
+
+	CodeInvalidPoll   sdk.CodeType = 1
+	CodeUnknownPoll   sdk.CodeType = 2
+	CodePollEnded     sdk.CodeType = 3
+	CodeInvalidOption sdk.CodeType = 4
+	CodeTooManyPolls  sdk.CodeType = 5
+)
+
+func ErrInvalidPoll(codespace sdk.CodespaceType, reason string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidPoll, fmt.Sprintf("invalid poll: %s", reason))
+}
+
+func ErrUnknownPoll(codespace sdk.CodespaceType, pollID uint64) sdk.Error {
+	return sdk.NewError(codespace, CodeUnknownPoll, fmt.Sprintf("unknown poll %d", pollID))
+}
+
+func ErrPollEnded(codespace sdk.CodespaceType, pollID uint64) sdk.Error {
+	return sdk.NewError(codespace, CodePollEnded, fmt.Sprintf("poll %d has ended", pollID))
+}
+
+func ErrInvalidOption(codespace sdk.CodespaceType, option uint32) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidOption, fmt.Sprintf("invalid option %d", option))
+}
+
+func ErrTooManyActivePolls(codespace sdk.CodespaceType, max uint64) sdk.Error {
+	return sdk.NewError(codespace, CodeTooManyPolls, fmt.Sprintf("creator already has %d active polls", max))
+}
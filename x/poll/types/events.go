@@ -0,0 +1,12 @@
+package types
+
+var (
+	EventTypeCreatePoll = "create_poll"
+	EventTypeVotePoll   = "vote_poll"
+	EventTypeEndPoll    = "end_poll"
+
+	AttributeKeyPollID     = "poll_id"
+	AttributeKeyOption     = "option"
+	AttributeKeyEndHeight  = "end_height"
+	AttributeValueCategory = ModuleName
+)
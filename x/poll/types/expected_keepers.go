@@ -0,0 +1,12 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/exported"
+)
+
+// StakingKeeper expected staking keeper for weighting the votes by stake
+type StakingKeeper interface {
+	Validator(ctx sdk.Context, addr sdk.ValAddress) exported.ValidatorI
+	IterateDelegations(ctx sdk.Context, delegator sdk.AccAddress, fn func(index int64, delegation exported.DelegationI) (stop bool))
+}
@@ -0,0 +1,41 @@
+package types
+
+import (
+	"fmt"
+)
+
+type GenesisState struct {
+	Params Params `json:"params" yaml:"params"`
+	Polls  []Poll `json:"polls" yaml:"polls"`
+	Votes  []Vote `json:"votes" yaml:"votes"`
+}
+
+func DefaultGenesisState() GenesisState {
+	return GenesisState{
+		Params: DefaultParams(),
+	}
+}
+
+func ValidateGenesis(data GenesisState) error {
+	err := data.Params.Validate()
+	if err != nil {
+		return err
+	}
+	polls := make(map[uint64]Poll)
+	for _, poll := range data.Polls {
+		if _, found := polls[poll.ID]; found {
+			return fmt.Errorf("duplicated poll %d", poll.ID)
+		}
+		polls[poll.ID] = poll
+	}
+	for _, vote := range data.Votes {
+		poll, found := polls[vote.PollID]
+		if !found {
+			return fmt.Errorf("vote on unknown poll %d", vote.PollID)
+		}
+		if int(vote.Option) >= len(poll.Options) {
+			return fmt.Errorf("invalid option %d in vote on poll %d", vote.Option, vote.PollID)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,91 @@
+package types
+
+import (
+	"encoding/binary"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	ModuleName   = "poll"
+	StoreKey     = ModuleName
+	QuerierRoute = ModuleName
+	RouterKey    = ModuleName
+)
+
+var (
+	PollKeyPrefix     = []byte{0x11}
+	VoteKeyPrefix     = []byte{0x12}
+	ActivePollsPrefix = []byte{0x13}
+	NextPollIDKey     = []byte{0x14}
+
+	CreatorActivePollsPrefix = []byte{0x15}
+	EndedPollsPrefix         = []byte{0x16}
+	PollTallyKeyPrefix       = []byte{0x17}
+)
+
+func uint64Bytes(n uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, n)
+	return bz
+}
+
+// PollKey gets the key for the poll
+func PollKey(pollID uint64) []byte {
+	return append(PollKeyPrefix, uint64Bytes(pollID)...)
+}
+
+// PollVotesPrefix gets the prefix of the votes of the poll
+func PollVotesPrefix(pollID uint64) []byte {
+	return append(VoteKeyPrefix, uint64Bytes(pollID)...)
+}
+
+// VoteKey gets the key for the vote of the voter on the poll
+func VoteKey(pollID uint64, voter sdk.AccAddress) []byte {
+	return append(PollVotesPrefix(pollID), voter.Bytes()...)
+}
+
+// ActivePollsByHeightPrefix gets the prefix of the active polls ending at the given height
+func ActivePollsByHeightPrefix(endHeight int64) []byte {
+	return append(ActivePollsPrefix, uint64Bytes(uint64(endHeight))...)
+}
+
+// ActivePollKey gets the key for the active poll in the queue ordered by end height
+func ActivePollKey(endHeight int64, pollID uint64) []byte {
+	return append(ActivePollsByHeightPrefix(endHeight), uint64Bytes(pollID)...)
+}
+
+// SplitActivePollKey gets the poll ID from the active poll key
+func SplitActivePollKey(key []byte) (pollID uint64) {
+	return binary.BigEndian.Uint64(key[len(ActivePollsPrefix)+8:])
+}
+
+// CreatorActivePollsKeyPrefix gets the prefix of the active polls created by the creator
+func CreatorActivePollsKeyPrefix(creator sdk.AccAddress) []byte {
+	return append(CreatorActivePollsPrefix, creator.Bytes()...)
+}
+
+// CreatorActivePollKey gets the key for the active poll in the index by creator
+func CreatorActivePollKey(creator sdk.AccAddress, pollID uint64) []byte {
+	return append(CreatorActivePollsKeyPrefix(creator), uint64Bytes(pollID)...)
+}
+
+// EndedPollsByHeightPrefix gets the prefix of the ended polls whose end height is the given height
+func EndedPollsByHeightPrefix(endHeight int64) []byte {
+	return append(EndedPollsPrefix, uint64Bytes(uint64(endHeight))...)
+}
+
+// EndedPollKey gets the key for the ended poll in the queue ordered by end height, from which the polls are pruned
+func EndedPollKey(endHeight int64, pollID uint64) []byte {
+	return append(EndedPollsByHeightPrefix(endHeight), uint64Bytes(pollID)...)
+}
+
+// SplitEndedPollKey gets the poll ID from the ended poll key
+func SplitEndedPollKey(key []byte) (pollID uint64) {
+	return binary.BigEndian.Uint64(key[len(EndedPollsPrefix)+8:])
+}
+
+// PollTallyKey gets the key for the running tally of the active poll
+func PollTallyKey(pollID uint64) []byte {
+	return append(PollTallyKeyPrefix, uint64Bytes(pollID)...)
+}
@@ -0,0 +1,116 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	MaxTitleLength       = 140
+	MaxDescriptionLength = 5000
+	MaxOptionLength      = 140
+)
+
+var (
+	_ sdk.Msg = &MsgCreatePoll{}
+	_ sdk.Msg = &MsgVote{}
+)
+
+type MsgCreatePoll struct {
+	Creator     sdk.AccAddress `json:"creator" yaml:"creator"`
+	Title       string         `json:"title" yaml:"title"`
+	Description string         `json:"description" yaml:"description"`
+	Options     []string       `json:"options" yaml:"options"`
+	WeightMode  WeightMode     `json:"weight_mode" yaml:"weight_mode"`
+	EndHeight   int64          `json:"end_height" yaml:"end_height"`
+}
+
+func NewMsgCreatePoll(creator sdk.AccAddress, title, description string, options []string, weightMode WeightMode, endHeight int64) MsgCreatePoll {
+	return MsgCreatePoll{
+		Creator:     creator,
+		Title:       title,
+		Description: description,
+		Options:     options,
+		WeightMode:  weightMode,
+		EndHeight:   endHeight,
+	}
+}
+
+func (msg MsgCreatePoll) Route() string { return RouterKey }
+func (msg MsgCreatePoll) Type() string  { return "create_poll" }
+
+func (msg MsgCreatePoll) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Creator}
+}
+
+func (msg MsgCreatePoll) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgCreatePoll) ValidateBasic() sdk.Error {
+	if msg.Creator.Empty() {
+		return sdk.ErrInvalidAddress(msg.Creator.String())
+	}
+	if len(strings.TrimSpace(msg.Title)) == 0 || len(msg.Title) > MaxTitleLength {
+		return ErrInvalidPoll(DefaultCodespace, fmt.Sprintf("title must be non-empty and at most %d characters", MaxTitleLength))
+	}
+	if len(msg.Description) > MaxDescriptionLength {
+		return ErrInvalidPoll(DefaultCodespace, fmt.Sprintf("description must be at most %d characters", MaxDescriptionLength))
+	}
+	if len(msg.Options) < 2 {
+		return ErrInvalidPoll(DefaultCodespace, "poll must have at least 2 options")
+	}
+	seen := make(map[string]bool)
+	for _, option := range msg.Options {
+		if len(strings.TrimSpace(option)) == 0 || len(option) > MaxOptionLength {
+			return ErrInvalidPoll(DefaultCodespace, fmt.Sprintf("option must be non-empty and at most %d characters", MaxOptionLength))
+		}
+		if seen[option] {
+			return ErrInvalidPoll(DefaultCodespace, fmt.Sprintf("duplicated option %s", option))
+		}
+		seen[option] = true
+	}
+	if !msg.WeightMode.IsValid() {
+		return ErrInvalidPoll(DefaultCodespace, fmt.Sprintf("invalid weight mode %s", msg.WeightMode))
+	}
+	if msg.EndHeight <= 0 {
+		return ErrInvalidPoll(DefaultCodespace, "end height must be positive")
+	}
+	return nil
+}
+
+type MsgVote struct {
+	PollID uint64         `json:"poll_id" yaml:"poll_id"`
+	Voter  sdk.AccAddress `json:"voter" yaml:"voter"`
+	Option uint32         `json:"option" yaml:"option"`
+}
+
+func NewMsgVote(pollID uint64, voter sdk.AccAddress, option uint32) MsgVote {
+	return MsgVote{
+		PollID: pollID,
+		Voter:  voter,
+		Option: option,
+	}
+}
+
+func (msg MsgVote) Route() string { return RouterKey }
+func (msg MsgVote) Type() string  { return "vote" }
+
+func (msg MsgVote) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Voter}
+}
+
+func (msg MsgVote) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgVote) ValidateBasic() sdk.Error {
+	if msg.Voter.Empty() {
+		return sdk.ErrInvalidAddress(msg.Voter.String())
+	}
+	return nil
+}
@@ -0,0 +1,99 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/x/params"
+)
+
+type Params struct {
+	MaxOptions      uint64 `json:"max_options" yaml:"max_options"`
+	MaxVotingBlocks int64  `json:"max_voting_blocks" yaml:"max_voting_blocks"`
+
+	// limits the polls each account can have before they end, since polls can be created without any deposit
+	MaxActivePolls uint64 `json:"max_active_polls" yaml:"max_active_polls"`
+
+	// ended polls are pruned with their votes after the retention period, deleting at most the given number of polls
+	// and votes in each block
+	PollRetentionBlocks int64  `json:"poll_retention_blocks" yaml:"poll_retention_blocks"`
+	MaxPrunesPerBlock   uint64 `json:"max_prunes_per_block" yaml:"max_prunes_per_block"`
+}
+
+var (
+	KeyMaxOptions          = []byte("MaxOptions")
+	KeyMaxVotingBlocks     = []byte("MaxVotingBlocks")
+	KeyMaxActivePolls      = []byte("MaxActivePolls")
+	KeyPollRetentionBlocks = []byte("PollRetentionBlocks")
+	KeyMaxPrunesPerBlock   = []byte("MaxPrunesPerBlock")
+)
+
+var _ params.ParamSet = (*Params)(nil)
+
+// Implements params.ParamSet
+func (p *Params) ParamSetPairs() params.ParamSetPairs {
+	return params.ParamSetPairs{
+		{Key: KeyMaxOptions, Value: &p.MaxOptions},
+		{Key: KeyMaxVotingBlocks, Value: &p.MaxVotingBlocks},
+		{Key: KeyMaxActivePolls, Value: &p.MaxActivePolls},
+		{Key: KeyPollRetentionBlocks, Value: &p.PollRetentionBlocks},
+		{Key: KeyMaxPrunesPerBlock, Value: &p.MaxPrunesPerBlock},
+	}
+}
+
+// DefaultParams allows polls with up to 10 options lasting for about 30 days with 5 seconds block time, at most 3 of
+// them from each account at a time, and keeps ended polls for another 30 days
+func DefaultParams() Params {
+	return Params{
+		MaxOptions:          10,
+		MaxVotingBlocks:     518400,
+		MaxActivePolls:      3,
+		PollRetentionBlocks: 518400,
+		MaxPrunesPerBlock:   1000,
+	}
+}
+
+func (p Params) Validate() error {
+	if p.MaxOptions < 2 {
+		return fmt.Errorf("max options must be at least 2: %d", p.MaxOptions)
+	}
+	if p.MaxVotingBlocks <= 0 {
+		return fmt.Errorf("max voting blocks must be positive: %d", p.MaxVotingBlocks)
+	}
+	if p.MaxActivePolls == 0 {
+		return fmt.Errorf("max active polls must be positive")
+	}
+	if p.PollRetentionBlocks < 0 {
+		return fmt.Errorf("poll retention blocks must not be negative: %d", p.PollRetentionBlocks)
+	}
+	if p.MaxPrunesPerBlock == 0 {
+		return fmt.Errorf("max prunes per block must be positive")
+	}
+	return nil
+}
+
+func (p Params) String() string {
+	return fmt.Sprintf(`Params:
+  Max Options:           %d
+  Max Voting Blocks:     %d
+  Max Active Polls:      %d
+  Poll Retention Blocks: %d
+  Max Prunes Per Block:  %d`,
+		p.MaxOptions, p.MaxVotingBlocks, p.MaxActivePolls, p.PollRetentionBlocks, p.MaxPrunesPerBlock)
+}
+
+func MustUnmarshalParams(cdc *codec.Codec, value []byte) Params {
+	params, err := UnmarshalParams(cdc, value)
+	if err != nil {
+		panic(err)
+	}
+	return params
+}
+
+func UnmarshalParams(cdc *codec.Codec, value []byte) (params Params, err error) {
+	err = cdc.UnmarshalBinaryLengthPrefixed(value, &params)
+	if err != nil {
+		return
+	}
+	return
+}
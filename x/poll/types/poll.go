@@ -0,0 +1,138 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// WeightMode defines how the votes of a poll are weighted
+type WeightMode string
+
+const (
+	// each vote is weighted by the bonded tokens delegated by the voter
+	WeightModeStake WeightMode = "stake"
+	// each account has one vote
+	WeightModeAccount WeightMode = "account"
+)
+
+func (mode WeightMode) IsValid() bool {
+	switch mode {
+	case WeightModeStake, WeightModeAccount:
+		return true
+	}
+	return false
+}
+
+// Poll is a signalling poll without any binding effect on the chain
+type Poll struct {
+	ID          uint64         `json:"id" yaml:"id"`
+	Creator     sdk.AccAddress `json:"creator" yaml:"creator"`
+	Title       string         `json:"title" yaml:"title"`
+	Description string         `json:"description" yaml:"description"`
+	Options     []string       `json:"options" yaml:"options"`
+	WeightMode  WeightMode     `json:"weight_mode" yaml:"weight_mode"`
+	EndHeight   int64          `json:"end_height" yaml:"end_height"`
+
+	// set when the poll ends, after which votes are no longer accepted
+	FinalResult *PollResult `json:"final_result,omitempty" yaml:"final_result,omitempty"`
+}
+
+func (p Poll) IsEnded() bool {
+	return p.FinalResult != nil
+}
+
+func (p Poll) String() string {
+	status := "active"
+	if p.IsEnded() {
+		status = "ended"
+	}
+	return fmt.Sprintf(`Poll %d:
+  Creator:     %s
+  Title:       %s
+  Description: %s
+  Options:     %s
+  Weight Mode: %s
+  End Height:  %d
+  Status:      %s`,
+		p.ID, p.Creator, p.Title, p.Description, strings.Join(p.Options, ", "), p.WeightMode, p.EndHeight, status)
+}
+
+type Polls []Poll
+
+func (ps Polls) String() string {
+	out := ""
+	for _, p := range ps {
+		out += fmt.Sprintf("%d - %s\n", p.ID, p.Title)
+	}
+	return strings.TrimSpace(out)
+}
+
+// Vote is the option chosen by a voter on a poll, which can be changed until the poll ends
+type Vote struct {
+	PollID uint64         `json:"poll_id" yaml:"poll_id"`
+	Voter  sdk.AccAddress `json:"voter" yaml:"voter"`
+	Option uint32         `json:"option" yaml:"option"`
+}
+
+func NewVote(pollID uint64, voter sdk.AccAddress, option uint32) Vote {
+	return Vote{
+		PollID: pollID,
+		Voter:  voter,
+		Option: option,
+	}
+}
+
+// OptionResult is the total weight of the votes on an option
+type OptionResult struct {
+	Option string  `json:"option" yaml:"option"`
+	Votes  uint64  `json:"votes" yaml:"votes"`
+	Weight sdk.Dec `json:"weight" yaml:"weight"`
+}
+
+// PollResult is the tally of a poll, which is final once the poll has ended
+type PollResult struct {
+	PollID      uint64         `json:"poll_id" yaml:"poll_id"`
+	Final       bool           `json:"final" yaml:"final"`
+	Results     []OptionResult `json:"results" yaml:"results"`
+	TotalWeight sdk.Dec        `json:"total_weight" yaml:"total_weight"`
+}
+
+// NewPollResult returns the result of the poll without any votes
+func NewPollResult(poll Poll) PollResult {
+	result := PollResult{
+		PollID:      poll.ID,
+		Results:     make([]OptionResult, len(poll.Options)),
+		TotalWeight: sdk.ZeroDec(),
+	}
+	for i, option := range poll.Options {
+		result.Results[i] = OptionResult{Option: option, Weight: sdk.ZeroDec()}
+	}
+	return result
+}
+
+// AddVote counts a vote of the given weight on the option in the result
+func (r *PollResult) AddVote(option uint32, weight sdk.Dec) {
+	optionResult := &r.Results[option]
+	optionResult.Votes++
+	optionResult.Weight = optionResult.Weight.Add(weight)
+	r.TotalWeight = r.TotalWeight.Add(weight)
+}
+
+// RemoveVote reverts AddVote, when the vote is replaced by a new vote of the same voter
+func (r *PollResult) RemoveVote(option uint32, weight sdk.Dec) {
+	optionResult := &r.Results[option]
+	optionResult.Votes--
+	optionResult.Weight = optionResult.Weight.Sub(weight)
+	r.TotalWeight = r.TotalWeight.Sub(weight)
+}
+
+func (r PollResult) String() string {
+	out := fmt.Sprintf("Poll %d Result (final: %t):\n", r.PollID, r.Final)
+	for _, result := range r.Results {
+		out += fmt.Sprintf("  %s: %s (%d votes)\n", result.Option, result.Weight, result.Votes)
+	}
+	out += fmt.Sprintf("  Total Weight: %s", r.TotalWeight)
+	return out
+}
@@ -0,0 +1,19 @@
+package types
+
+const (
+	QueryParams = "params"
+	QueryPolls  = "polls"
+	QueryPoll   = "poll"
+	QueryResult = "result"
+)
+
+// Params for queries 'custom/poll/poll' and 'custom/poll/result'
+type QueryPollParams struct {
+	PollID uint64
+}
+
+func NewQueryPollParams(pollID uint64) QueryPollParams {
+	return QueryPollParams{
+		PollID: pollID,
+	}
+}
@@ -1,4 +1,13 @@
-// This is a wrapper on the x/staking module.
-// It wraps the MsgCreateValidator handler with whitelist module, to provide whitelist filtering on MsgCreateValidator.
+// This is a wrapper on the x/staking module, which passes the staking messages through the checks of the whitelist
+// module before handling them with the x/staking handler.
+//
+// MsgCreateValidator is rejected if the validator is not in the whitelist, when the whitelist is not empty.
+// MsgCreateValidator and MsgEditValidator are also rejected if they set a MinSelfDelegation below the minimum in the
+// whitelist params, or a commission outside the commission bounds in the whitelist params. The whitelist module keeps
+// a registry of the monikers of validators, and of their identities if the UniqueIdentity param is set, and rejects
+// the messages using the moniker or identity of another validator case-insensitively.
+//
+// MsgDelegate and MsgBeginRedelegate are rejected if the destination validator would exceed the delegation cap in the
+// whitelist params, or if the destination validator is not in the whitelist when the RestrictDelegation param is set.
 
 package staking
@@ -0,0 +1,49 @@
+package upgrade
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// BeginBlocker halts the chain at the height of the scheduled upgrade plan, unless the running binary has registered
+// the handler of the plan, in which case the upgrade is applied.
+func BeginBlocker(ctx sdk.Context, keeper Keeper) {
+	plan, found := keeper.GetUpgradePlan(ctx)
+	if !found {
+		return
+	}
+
+	if plan.ShouldExecute(ctx) {
+		if !keeper.HasUpgradeHandler(plan.Name) {
+			// the panic stops the node before the block is committed, so the new binary restarts from this block
+			upgradeMsg := fmt.Sprintf(
+				"UPGRADE \"%s\" NEEDED at height %d: %s. Please replace the binary with the upgraded version and restart the node.",
+				plan.Name, plan.Height, plan.Info,
+			)
+			keeper.Logger(ctx).Error(upgradeMsg)
+			panic(upgradeMsg)
+		}
+		keeper.Logger(ctx).Info(fmt.Sprintf("applying upgrade \"%s\" at height %d", plan.Name, ctx.BlockHeight()))
+		ctx = ctx.WithBlockGasMeter(sdk.NewInfiniteGasMeter())
+		keeper.ApplyUpgrade(ctx, plan)
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				EventTypeApplyUpgrade,
+				sdk.NewAttribute(AttributeKeyName, plan.Name),
+				sdk.NewAttribute(AttributeKeyHeight, fmt.Sprintf("%d", ctx.BlockHeight())),
+			),
+		)
+		return
+	}
+
+	// a binary with the handler of a pending upgrade would apply migrations unknown to the nodes running the old binary
+	if keeper.HasUpgradeHandler(plan.Name) {
+		errMsg := fmt.Sprintf(
+			"BINARY UPDATED BEFORE TRIGGER! UPGRADE \"%s\" is scheduled at height %d, but the binary already contains its handler",
+			plan.Name, plan.Height,
+		)
+		keeper.Logger(ctx).Error(errMsg)
+		panic(errMsg)
+	}
+}
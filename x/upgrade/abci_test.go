@@ -0,0 +1,100 @@
+package upgrade
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// testInput holds the context of the current block
+type testInput struct {
+	Ctx sdk.Context
+}
+
+// NextBlock returns the context of the next block, the given duration after the current block
+func (input *testInput) NextBlock(d time.Duration) sdk.Context {
+	header := input.Ctx.BlockHeader()
+	header.Height++
+	header.Time = header.Time.Add(d)
+	input.Ctx = input.Ctx.WithBlockHeader(header)
+	return input.Ctx
+}
+
+func createTestInput(t *testing.T) (*testInput, Keeper) {
+	key := sdk.NewKVStoreKey(StoreKey)
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(key, sdk.StoreTypeIAVL, db)
+	err := ms.LoadLatestVersion()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	header := abci.Header{ChainID: "likechain-test", Height: 1, Time: time.Unix(1500000000, 0).UTC()}
+	ctx := sdk.NewContext(ms, header, false, log.NewNopLogger())
+	cdc := codec.New()
+	RegisterCodec(cdc)
+	return &testInput{Ctx: ctx}, NewKeeper(cdc, key, DefaultCodespace)
+}
+
+func scheduleUpgrade(t *testing.T, ctx sdk.Context, keeper Keeper, plan Plan) {
+	err := NewSoftwareUpgradeProposalHandler(keeper)(ctx, NewSoftwareUpgradeProposal("title", "description", plan))
+	require.NoError(t, err)
+}
+
+func TestBeginBlockerHaltsAtPlanHeight(t *testing.T) {
+	input, keeper := createTestInput(t)
+	plan := NewPlan("test", 3, "info")
+	scheduleUpgrade(t, input.Ctx, keeper, plan)
+
+	ctx := input.NextBlock(0)
+	require.NotPanics(t, func() { BeginBlocker(ctx, keeper) })
+
+	ctx = input.NextBlock(0)
+	require.Panics(t, func() { BeginBlocker(ctx, keeper) })
+	// the plan is kept, so the node halts again if it is restarted with the old binary
+	current, found := keeper.GetUpgradePlan(ctx)
+	require.True(t, found)
+	require.Equal(t, plan, current)
+	_, done := keeper.GetDoneHeight(ctx, plan.Name)
+	require.False(t, done)
+}
+
+func TestBeginBlockerAppliesPlanWithHandler(t *testing.T) {
+	input, keeper := createTestInput(t)
+	plan := NewPlan("test", 3, "info")
+	scheduleUpgrade(t, input.Ctx, keeper, plan)
+
+	var appliedPlan *Plan
+	keeper.SetUpgradeHandler(plan.Name, func(ctx sdk.Context, plan Plan) {
+		appliedPlan = &plan
+	})
+
+	// a binary with the handler must not run before the plan height
+	ctx := input.NextBlock(0)
+	require.Panics(t, func() { BeginBlocker(ctx, keeper) })
+	require.Nil(t, appliedPlan)
+
+	ctx = input.NextBlock(0).WithEventManager(sdk.NewEventManager())
+	require.NotPanics(t, func() { BeginBlocker(ctx, keeper) })
+	require.Equal(t, &plan, appliedPlan)
+	require.Equal(t, EventTypeApplyUpgrade, ctx.EventManager().Events()[0].Type)
+	_, found := keeper.GetUpgradePlan(ctx)
+	require.False(t, found)
+	height, done := keeper.GetDoneHeight(ctx, plan.Name)
+	require.True(t, done)
+	require.Equal(t, int64(3), height)
+
+	// the applied plan is cleared, so the following blocks run normally
+	ctx = input.NextBlock(0)
+	require.NotPanics(t, func() { BeginBlocker(ctx, keeper) })
+}
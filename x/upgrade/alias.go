@@ -0,0 +1,50 @@
+package upgrade
+
+import (
+	"github.com/likecoin/likechain/x/upgrade/types"
+)
+
+const (
+	ModuleName                        = types.ModuleName
+	StoreKey                          = types.StoreKey
+	QuerierRoute                      = types.QuerierRoute
+	RouterKey                         = types.RouterKey
+	QueryCurrent                      = types.QueryCurrent
+	QueryApplied                      = types.QueryApplied
+	ProposalTypeSoftwareUpgrade       = types.ProposalTypeSoftwareUpgrade
+	ProposalTypeCancelSoftwareUpgrade = types.ProposalTypeCancelSoftwareUpgrade
+	DefaultCodespace                  = types.DefaultCodespace
+)
+
+var (
+	ModuleCdc                        = types.ModuleCdc
+	RegisterCodec                    = types.RegisterCodec
+	NewPlan                          = types.NewPlan
+	NewSoftwareUpgradeProposal       = types.NewSoftwareUpgradeProposal
+	NewCancelSoftwareUpgradeProposal = types.NewCancelSoftwareUpgradeProposal
+	NewQueryAppliedParams            = types.NewQueryAppliedParams
+	ErrInvalidPlan                   = types.ErrInvalidPlan
+	ErrPlanNotFound                  = types.ErrPlanNotFound
+	PlanKey                          = types.PlanKey
+	DoneByNameKey                    = types.DoneByNameKey
+	DoneByNameKeyPrefix              = types.DoneByNameKeyPrefix
+	NewDoneUpgrade                   = types.NewDoneUpgrade
+	NewGenesisState                  = types.NewGenesisState
+	DefaultGenesisState              = types.DefaultGenesisState
+	ValidateGenesis                  = types.ValidateGenesis
+	EventTypeScheduleUpgrade         = types.EventTypeScheduleUpgrade
+	EventTypeCancelUpgrade           = types.EventTypeCancelUpgrade
+	EventTypeApplyUpgrade            = types.EventTypeApplyUpgrade
+	AttributeKeyName                 = types.AttributeKeyName
+	AttributeKeyHeight               = types.AttributeKeyHeight
+	AttributeValueCategory           = types.AttributeValueCategory
+)
+
+type (
+	Plan                          = types.Plan
+	SoftwareUpgradeProposal       = types.SoftwareUpgradeProposal
+	CancelSoftwareUpgradeProposal = types.CancelSoftwareUpgradeProposal
+	QueryAppliedParams            = types.QueryAppliedParams
+	GenesisState                  = types.GenesisState
+	DoneUpgrade                   = types.DoneUpgrade
+)
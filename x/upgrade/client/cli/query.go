@@ -0,0 +1,94 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/likecoin/likechain/x/upgrade/types"
+)
+
+// GetQueryCmd returns the cli query commands for this module
+func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	upgradeQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the upgrade module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	upgradeQueryCmd.AddCommand(client.GetCommands(
+		GetCmdQueryPlan(queryRoute, cdc),
+		GetCmdQueryApplied(queryRoute, cdc),
+	)...)
+
+	return upgradeQueryCmd
+}
+
+// GetCmdQueryPlan implements the query command for the currently scheduled upgrade plan.
+func GetCmdQueryPlan(storeName string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "plan",
+		Short: "Query the currently scheduled upgrade plan",
+		Long: strings.TrimSpace(`Query the currently scheduled upgrade plan:
+
+$ likecli query upgrade plan
+`),
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.Query(fmt.Sprintf("custom/%s/%s", storeName, types.QueryCurrent))
+			if err != nil {
+				return err
+			}
+
+			if len(res) == 0 {
+				return fmt.Errorf("no upgrade scheduled")
+			}
+
+			var plan types.Plan
+			cdc.MustUnmarshalJSON(res, &plan)
+			return cliCtx.PrintOutput(plan)
+		},
+	}
+}
+
+// GetCmdQueryApplied implements the query command for the height at which an upgrade was applied.
+func GetCmdQueryApplied(storeName string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "applied [upgrade-name]",
+		Short: "Query the height at which an upgrade was applied",
+		Long: strings.TrimSpace(`Query the height at which an upgrade was applied:
+
+$ likecli query upgrade applied my-upgrade
+`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			bz, err := cdc.MarshalJSON(types.NewQueryAppliedParams(args[0]))
+			if err != nil {
+				return err
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", storeName, types.QueryApplied), bz)
+			if err != nil {
+				return err
+			}
+
+			if len(res) == 0 {
+				return fmt.Errorf("upgrade %s has not been applied", args[0])
+			}
+
+			var height int64
+			cdc.MustUnmarshalJSON(res, &height)
+			fmt.Println(height)
+			return nil
+		},
+	}
+}
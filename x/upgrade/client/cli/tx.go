@@ -0,0 +1,114 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+
+	"github.com/likecoin/likechain/x/upgrade/types"
+)
+
+const (
+	FlagUpgradeHeight = "upgrade-height"
+	FlagUpgradeInfo   = "upgrade-info"
+)
+
+// GetCmdSubmitUpgradeProposal implements the command to submit a software upgrade proposal
+func GetCmdSubmitUpgradeProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "software-upgrade [name]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a software upgrade proposal",
+		Long: strings.TrimSpace(`Submit a software upgrade proposal along with an initial deposit.
+Nodes halt at the upgrade height unless they run a binary registering an upgrade handler with the given name.
+
+Example:
+$ likecli tx gov submit-proposal software-upgrade v2 --upgrade-height 100000 --upgrade-info "https://..." \
+    --title "Upgrade to v2" --description "..." --deposit 1000000nanolike --from mykey
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			height := viper.GetInt64(FlagUpgradeHeight)
+			if height <= 0 {
+				return fmt.Errorf("--%s must be positive", FlagUpgradeHeight)
+			}
+			deposit, err := sdk.ParseCoins(viper.GetString(govcli.FlagDeposit))
+			if err != nil {
+				return err
+			}
+
+			plan := types.NewPlan(args[0], height, viper.GetString(FlagUpgradeInfo))
+			content := types.NewSoftwareUpgradeProposal(
+				viper.GetString(govcli.FlagTitle), viper.GetString(govcli.FlagDescription), plan,
+			)
+
+			msg := gov.NewMsgSubmitProposal(content, deposit, cliCtx.GetFromAddress())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+	cmd.Flags().Int64(FlagUpgradeHeight, 0, "the height at which the upgrade is applied")
+	cmd.Flags().String(FlagUpgradeInfo, "", "information about the upgrade, e.g. the release of the new binary")
+
+	return cmd
+}
+
+// GetCmdSubmitCancelUpgradeProposal implements the command to submit a proposal cancelling the scheduled upgrade
+func GetCmdSubmitCancelUpgradeProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-software-upgrade",
+		Args:  cobra.ExactArgs(0),
+		Short: "Submit a proposal cancelling the scheduled software upgrade",
+		Long: strings.TrimSpace(`Submit a proposal cancelling the scheduled software upgrade along with an initial deposit.
+
+Example:
+$ likecli tx gov submit-proposal cancel-software-upgrade --title "Cancel v2" --description "..." \
+    --deposit 1000000nanolike --from mykey
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			deposit, err := sdk.ParseCoins(viper.GetString(govcli.FlagDeposit))
+			if err != nil {
+				return err
+			}
+
+			content := types.NewCancelSoftwareUpgradeProposal(
+				viper.GetString(govcli.FlagTitle), viper.GetString(govcli.FlagDescription),
+			)
+
+			msg := gov.NewMsgSubmitProposal(content, deposit, cliCtx.GetFromAddress())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+
+	return cmd
+}
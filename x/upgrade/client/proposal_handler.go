@@ -0,0 +1,14 @@
+package client
+
+import (
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+
+	"github.com/likecoin/likechain/x/upgrade/client/cli"
+	"github.com/likecoin/likechain/x/upgrade/client/rest"
+)
+
+// upgrade proposal handlers, to be registered on the gov module
+var (
+	ProposalHandler       = govclient.NewProposalHandler(cli.GetCmdSubmitUpgradeProposal, rest.ProposalRESTHandler)
+	CancelProposalHandler = govclient.NewProposalHandler(cli.GetCmdSubmitCancelUpgradeProposal, rest.CancelProposalRESTHandler)
+)
@@ -0,0 +1,13 @@
+// This module implements coordinated software upgrades through governance.
+//
+// A passed SoftwareUpgradeProposal schedules an upgrade plan, replacing the scheduled one if any, and a passed
+// CancelSoftwareUpgradeProposal clears it. Nodes running a binary without the handler of the plan halt in BeginBlocker
+// at the plan height, before the block is committed. The new binary registers the handler under the plan name, applies
+// it at the plan height and records the upgrade as done, so that an upgrade with the same name cannot be scheduled
+// again. Running the new binary before the plan height halts the node too, since it would apply migrations unknown to
+// the rest of the network.
+//
+// The scheduled plan and the done upgrades are carried over in the genesis state. The plan height is absolute, so it
+// has to be adjusted when the exported chain restarts from a lower height.
+
+package upgrade
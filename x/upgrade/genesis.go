@@ -0,0 +1,26 @@
+package upgrade
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+func InitGenesis(ctx sdk.Context, keeper Keeper, genesisState GenesisState) []abci.ValidatorUpdate {
+	for _, done := range genesisState.DoneUpgrades {
+		keeper.setDoneHeight(ctx, done.Name, done.Height)
+	}
+	// the plan height is absolute, so it is restored as is without the height check of ScheduleUpgrade, and should be
+	// adjusted by hand when the chain restarts from a lower height
+	if genesisState.Plan != nil {
+		keeper.setUpgradePlan(ctx, *genesisState.Plan)
+	}
+	return nil
+}
+
+func ExportGenesis(ctx sdk.Context, keeper Keeper) GenesisState {
+	var plan *Plan
+	if p, found := keeper.GetUpgradePlan(ctx); found {
+		plan = &p
+	}
+	return NewGenesisState(plan, keeper.GetDoneUpgrades(ctx))
+}
@@ -0,0 +1,50 @@
+package upgrade
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestExportImportGenesis(t *testing.T) {
+	input, keeper := createTestInput(t)
+	ctx := input.Ctx
+	require.Equal(t, DefaultGenesisState(), ExportGenesis(ctx, keeper))
+
+	plan := NewPlan("test3", 100, "info")
+	genesisState := NewGenesisState(&plan, []DoneUpgrade{NewDoneUpgrade("test1", 10), NewDoneUpgrade("test2", 20)})
+	require.NoError(t, ValidateGenesis(genesisState))
+	InitGenesis(ctx, keeper, genesisState)
+
+	current, found := keeper.GetUpgradePlan(ctx)
+	require.True(t, found)
+	require.Equal(t, plan, current)
+	height, done := keeper.GetDoneHeight(ctx, "test2")
+	require.True(t, done)
+	require.Equal(t, int64(20), height)
+
+	exported := ExportGenesis(ctx, keeper)
+	require.Equal(t, genesisState, exported)
+
+	// the exported state survives the JSON round trip of the genesis file
+	bz := ModuleCdc.MustMarshalJSON(exported)
+	var imported GenesisState
+	ModuleCdc.MustUnmarshalJSON(bz, &imported)
+	require.Equal(t, genesisState, imported)
+}
+
+func TestValidateGenesis(t *testing.T) {
+	require.NoError(t, ValidateGenesis(DefaultGenesisState()))
+
+	plan := NewPlan("test", 100, "")
+	for _, genesisState := range []GenesisState{
+		NewGenesisState(&Plan{Name: "", Height: 100}, nil),
+		NewGenesisState(&Plan{Name: "test", Height: 0}, nil),
+		NewGenesisState(nil, []DoneUpgrade{NewDoneUpgrade("", 10)}),
+		NewGenesisState(nil, []DoneUpgrade{NewDoneUpgrade("done", 0)}),
+		NewGenesisState(nil, []DoneUpgrade{NewDoneUpgrade("done", 10), NewDoneUpgrade("done", 20)}),
+		NewGenesisState(&plan, []DoneUpgrade{NewDoneUpgrade("test", 10)}),
+	} {
+		require.Error(t, ValidateGenesis(genesisState), "%+v", genesisState)
+	}
+}
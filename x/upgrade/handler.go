@@ -0,0 +1,55 @@
+package upgrade
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+)
+
+func NewSoftwareUpgradeProposalHandler(keeper Keeper) gov.Handler {
+	return func(ctx sdk.Context, content gov.Content) sdk.Error {
+		switch c := content.(type) {
+		case SoftwareUpgradeProposal:
+			return handleSoftwareUpgradeProposal(ctx, c, keeper)
+		case CancelSoftwareUpgradeProposal:
+			return handleCancelSoftwareUpgradeProposal(ctx, c, keeper)
+		default:
+			errMsg := fmt.Sprintf("unrecognized upgrade proposal content type: %T", c)
+			return sdk.ErrUnknownRequest(errMsg)
+		}
+	}
+}
+
+func handleSoftwareUpgradeProposal(ctx sdk.Context, p SoftwareUpgradeProposal, keeper Keeper) sdk.Error {
+	err := keeper.ScheduleUpgrade(ctx, p.Plan)
+	if err != nil {
+		return err
+	}
+	keeper.Logger(ctx).Info(fmt.Sprintf("upgrade %s scheduled at height %d", p.Plan.Name, p.Plan.Height))
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeScheduleUpgrade,
+			sdk.NewAttribute(AttributeKeyName, p.Plan.Name),
+			sdk.NewAttribute(AttributeKeyHeight, fmt.Sprintf("%d", p.Plan.Height)),
+		),
+	)
+	return nil
+}
+
+func handleCancelSoftwareUpgradeProposal(ctx sdk.Context, p CancelSoftwareUpgradeProposal, keeper Keeper) sdk.Error {
+	plan, found := keeper.GetUpgradePlan(ctx)
+	if !found {
+		return ErrPlanNotFound(keeper.Codespace())
+	}
+	keeper.ClearUpgradePlan(ctx)
+	keeper.Logger(ctx).Info(fmt.Sprintf("upgrade %s cancelled", plan.Name))
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeCancelUpgrade,
+			sdk.NewAttribute(AttributeKeyName, plan.Name),
+			sdk.NewAttribute(AttributeKeyHeight, fmt.Sprintf("%d", plan.Height)),
+		),
+	)
+	return nil
+}
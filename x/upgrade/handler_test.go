@@ -0,0 +1,48 @@
+package upgrade
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/x/gov"
+
+	"github.com/likecoin/likechain/x/upgrade/types"
+)
+
+func TestSoftwareUpgradeProposalHandler(t *testing.T) {
+	input, keeper := createTestInput(t)
+	ctx := input.Ctx
+	handler := NewSoftwareUpgradeProposalHandler(keeper)
+
+	err := handler(ctx, NewSoftwareUpgradeProposal("title", "description", NewPlan("test", ctx.BlockHeight(), "")))
+	require.Equal(t, types.CodeInvalidPlan, err.Code())
+
+	err = handler(ctx, NewCancelSoftwareUpgradeProposal("title", "description"))
+	require.Equal(t, types.CodePlanNotFound, err.Code())
+
+	scheduleUpgrade(t, ctx, keeper, NewPlan("test", 10, ""))
+	// a later proposal replaces the scheduled plan
+	plan := NewPlan("test2", 20, "")
+	scheduleUpgrade(t, ctx, keeper, plan)
+	current, found := keeper.GetUpgradePlan(ctx)
+	require.True(t, found)
+	require.Equal(t, plan, current)
+
+	err = handler(ctx, NewCancelSoftwareUpgradeProposal("title", "description"))
+	require.NoError(t, err)
+	_, found = keeper.GetUpgradePlan(ctx)
+	require.False(t, found)
+
+	err = handler(ctx, gov.NewTextProposal("title", "description"))
+	require.Error(t, err)
+}
+
+func TestScheduleAppliedUpgrade(t *testing.T) {
+	input, keeper := createTestInput(t)
+	keeper.setDoneHeight(input.Ctx, "test", 1)
+
+	err := NewSoftwareUpgradeProposalHandler(keeper)(input.Ctx,
+		NewSoftwareUpgradeProposal("title", "description", NewPlan("test", 10, "")))
+	require.Equal(t, types.CodeInvalidPlan, err.Code())
+}
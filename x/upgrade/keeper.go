@@ -0,0 +1,124 @@
+package upgrade
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// UpgradeHandler applies the state migrations of an upgrade, and is registered by the binary implementing the upgrade
+type UpgradeHandler func(ctx sdk.Context, plan Plan)
+
+type Keeper struct {
+	storeKey        sdk.StoreKey
+	cdc             *codec.Codec
+	codespace       sdk.CodespaceType
+	upgradeHandlers map[string]UpgradeHandler
+}
+
+func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, codespace sdk.CodespaceType) Keeper {
+	return Keeper{
+		storeKey:        key,
+		cdc:             cdc,
+		codespace:       codespace,
+		upgradeHandlers: map[string]UpgradeHandler{},
+	}
+}
+
+func (keeper Keeper) Codespace() sdk.CodespaceType {
+	return keeper.codespace
+}
+
+func (keeper Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", ModuleName))
+}
+
+// SetUpgradeHandler registers the handler for the upgrade plan with the given name
+func (keeper Keeper) SetUpgradeHandler(name string, upgradeHandler UpgradeHandler) {
+	keeper.upgradeHandlers[name] = upgradeHandler
+}
+
+func (keeper Keeper) HasUpgradeHandler(name string) bool {
+	_, ok := keeper.upgradeHandlers[name]
+	return ok
+}
+
+func (keeper Keeper) GetUpgradePlan(ctx sdk.Context) (plan Plan, found bool) {
+	bz := ctx.KVStore(keeper.storeKey).Get(PlanKey)
+	if bz == nil {
+		return plan, false
+	}
+	keeper.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &plan)
+	return plan, true
+}
+
+func (keeper Keeper) setUpgradePlan(ctx sdk.Context, plan Plan) {
+	bz := keeper.cdc.MustMarshalBinaryLengthPrefixed(plan)
+	ctx.KVStore(keeper.storeKey).Set(PlanKey, bz)
+}
+
+func (keeper Keeper) ClearUpgradePlan(ctx sdk.Context) {
+	ctx.KVStore(keeper.storeKey).Delete(PlanKey)
+}
+
+// ScheduleUpgrade schedules the upgrade plan, replacing the currently scheduled one if any
+func (keeper Keeper) ScheduleUpgrade(ctx sdk.Context, plan Plan) sdk.Error {
+	err := plan.ValidateBasic()
+	if err != nil {
+		return err
+	}
+	if plan.Height <= ctx.BlockHeight() {
+		return ErrInvalidPlan(keeper.codespace, "height has already passed")
+	}
+	if _, done := keeper.GetDoneHeight(ctx, plan.Name); done {
+		return ErrInvalidPlan(keeper.codespace, fmt.Sprintf("upgrade with name %s has already been applied", plan.Name))
+	}
+	keeper.setUpgradePlan(ctx, plan)
+	return nil
+}
+
+// GetDoneHeight returns the height at which the upgrade with the given name was applied
+func (keeper Keeper) GetDoneHeight(ctx sdk.Context, name string) (height int64, found bool) {
+	bz := ctx.KVStore(keeper.storeKey).Get(DoneByNameKey(name))
+	if bz == nil {
+		return 0, false
+	}
+	return int64(binary.BigEndian.Uint64(bz)), true
+}
+
+func (keeper Keeper) setDone(ctx sdk.Context, name string) {
+	keeper.setDoneHeight(ctx, name, ctx.BlockHeight())
+}
+
+func (keeper Keeper) setDoneHeight(ctx sdk.Context, name string, height int64) {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, uint64(height))
+	ctx.KVStore(keeper.storeKey).Set(DoneByNameKey(name), bz)
+}
+
+// GetDoneUpgrades returns the names and heights of all applied upgrades, ordered by name
+func (keeper Keeper) GetDoneUpgrades(ctx sdk.Context) (doneUpgrades []DoneUpgrade) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(keeper.storeKey), DoneByNameKeyPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		name := string(iterator.Key()[len(DoneByNameKeyPrefix):])
+		height := int64(binary.BigEndian.Uint64(iterator.Value()))
+		doneUpgrades = append(doneUpgrades, NewDoneUpgrade(name, height))
+	}
+	return doneUpgrades
+}
+
+// ApplyUpgrade runs the registered handler of the plan, and marks the plan as done
+func (keeper Keeper) ApplyUpgrade(ctx sdk.Context, plan Plan) {
+	handler, ok := keeper.upgradeHandlers[plan.Name]
+	if !ok {
+		panic(fmt.Sprintf("no upgrade handler registered for upgrade %s", plan.Name))
+	}
+	handler(ctx, plan)
+	keeper.ClearUpgradePlan(ctx)
+	keeper.setDone(ctx, plan.Name)
+}
@@ -0,0 +1,22 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	DefaultCodespace sdk.CodespaceType = ModuleName
+
+	CodeInvalidPlan  sdk.CodeType = 1
+	CodePlanNotFound sdk.CodeType = 2
+)
+
+func ErrInvalidPlan(codespace sdk.CodespaceType, reason string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidPlan, fmt.Sprintf("invalid upgrade plan: %s", reason))
+}
+
+func ErrPlanNotFound(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodePlanNotFound, "no upgrade plan found")
+}
@@ -0,0 +1,11 @@
+package types
+
+var (
+	EventTypeScheduleUpgrade = "schedule_upgrade"
+	EventTypeCancelUpgrade   = "cancel_upgrade"
+	EventTypeApplyUpgrade    = "apply_upgrade"
+
+	AttributeKeyName       = "name"
+	AttributeKeyHeight     = "height"
+	AttributeValueCategory = ModuleName
+)
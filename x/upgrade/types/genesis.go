@@ -0,0 +1,62 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+)
+
+// DoneUpgrade records the height at which the upgrade with the given name was applied
+type DoneUpgrade struct {
+	Name   string `json:"name" yaml:"name"`
+	Height int64  `json:"height" yaml:"height"`
+}
+
+func NewDoneUpgrade(name string, height int64) DoneUpgrade {
+	return DoneUpgrade{
+		Name:   name,
+		Height: height,
+	}
+}
+
+// GenesisState carries the scheduled upgrade plan, if any, and the applied upgrades over a genesis export, so that a
+// scheduled upgrade is not silently dropped and an applied upgrade cannot be scheduled again
+type GenesisState struct {
+	Plan         *Plan         `json:"plan,omitempty" yaml:"plan,omitempty"`
+	DoneUpgrades []DoneUpgrade `json:"done_upgrades" yaml:"done_upgrades"`
+}
+
+func NewGenesisState(plan *Plan, doneUpgrades []DoneUpgrade) GenesisState {
+	return GenesisState{
+		Plan:         plan,
+		DoneUpgrades: doneUpgrades,
+	}
+}
+
+func DefaultGenesisState() GenesisState {
+	return GenesisState{}
+}
+
+func ValidateGenesis(data GenesisState) error {
+	doneNames := make(map[string]bool)
+	for _, done := range data.DoneUpgrades {
+		if len(strings.TrimSpace(done.Name)) == 0 {
+			return fmt.Errorf("empty name in done upgrades")
+		}
+		if done.Height <= 0 {
+			return fmt.Errorf("invalid height of done upgrade %s: %d", done.Name, done.Height)
+		}
+		if doneNames[done.Name] {
+			return fmt.Errorf("duplicated done upgrade %s", done.Name)
+		}
+		doneNames[done.Name] = true
+	}
+	if data.Plan != nil {
+		if err := data.Plan.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid upgrade plan: %s", err.Error())
+		}
+		if doneNames[data.Plan.Name] {
+			return fmt.Errorf("upgrade plan %s has already been applied", data.Plan.Name)
+		}
+	}
+	return nil
+}
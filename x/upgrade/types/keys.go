@@ -0,0 +1,18 @@
+package types
+
+const (
+	ModuleName   = "upgrade"
+	StoreKey     = ModuleName
+	QuerierRoute = ModuleName
+	RouterKey    = ModuleName
+)
+
+var (
+	PlanKey             = []byte{0x11}
+	DoneByNameKeyPrefix = []byte{0x12}
+)
+
+// DoneByNameKey gets the key recording the height at which the upgrade with the given name was applied
+func DoneByNameKey(name string) []byte {
+	return append(DoneByNameKeyPrefix, []byte(name)...)
+}
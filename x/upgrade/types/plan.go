@@ -0,0 +1,50 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Plan specifies information about a planned upgrade and the height at which it should be applied
+type Plan struct {
+	// Name identifies the upgrade handler which the new binary should register for this upgrade
+	Name string `json:"name" yaml:"name"`
+
+	// Height is the height at which nodes running the old binary halt, and the new binary applies the upgrade
+	Height int64 `json:"height" yaml:"height"`
+
+	// Info is any application specific information, e.g. the release URL of the new binary
+	Info string `json:"info" yaml:"info"`
+}
+
+func NewPlan(name string, height int64, info string) Plan {
+	return Plan{
+		Name:   name,
+		Height: height,
+		Info:   info,
+	}
+}
+
+func (plan Plan) String() string {
+	return fmt.Sprintf(`Upgrade Plan:
+  Name:   %s
+  Height: %d
+  Info:   %s`, plan.Name, plan.Height, plan.Info)
+}
+
+func (plan Plan) ValidateBasic() sdk.Error {
+	if len(strings.TrimSpace(plan.Name)) == 0 {
+		return ErrInvalidPlan(DefaultCodespace, "name cannot be empty")
+	}
+	if plan.Height <= 0 {
+		return ErrInvalidPlan(DefaultCodespace, "height must be positive")
+	}
+	return nil
+}
+
+// ShouldExecute returns whether the upgrade should be applied in the current block
+func (plan Plan) ShouldExecute(ctx sdk.Context) bool {
+	return plan.Height > 0 && ctx.BlockHeight() >= plan.Height
+}
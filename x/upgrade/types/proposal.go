@@ -0,0 +1,94 @@
+package types
+
+import (
+	"fmt"
+
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// gov has registered the type name "SoftwareUpgrade" for its own signalling-only proposal
+const (
+	ProposalTypeSoftwareUpgrade       = "ScheduleUpgrade"
+	ProposalTypeCancelSoftwareUpgrade = "CancelUpgrade"
+)
+
+var (
+	_ govtypes.Content = SoftwareUpgradeProposal{}
+	_ govtypes.Content = CancelSoftwareUpgradeProposal{}
+)
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeSoftwareUpgrade)
+	govtypes.RegisterProposalTypeCodec(SoftwareUpgradeProposal{}, "likechain/SoftwareUpgradeProposal")
+	govtypes.RegisterProposalType(ProposalTypeCancelSoftwareUpgrade)
+	govtypes.RegisterProposalTypeCodec(CancelSoftwareUpgradeProposal{}, "likechain/CancelSoftwareUpgradeProposal")
+}
+
+// SoftwareUpgradeProposal schedules an upgrade plan, replacing the currently scheduled one if any
+type SoftwareUpgradeProposal struct {
+	Title       string `json:"title" yaml:"title"`
+	Description string `json:"description" yaml:"description"`
+	Plan        Plan   `json:"plan" yaml:"plan"`
+}
+
+func NewSoftwareUpgradeProposal(title, description string, plan Plan) SoftwareUpgradeProposal {
+	return SoftwareUpgradeProposal{
+		Title:       title,
+		Description: description,
+		Plan:        plan,
+	}
+}
+
+func (sup SoftwareUpgradeProposal) GetTitle() string       { return sup.Title }
+func (sup SoftwareUpgradeProposal) GetDescription() string { return sup.Description }
+func (sup SoftwareUpgradeProposal) ProposalRoute() string  { return RouterKey }
+func (sup SoftwareUpgradeProposal) ProposalType() string   { return ProposalTypeSoftwareUpgrade }
+
+func (sup SoftwareUpgradeProposal) ValidateBasic() sdk.Error {
+	err := govtypes.ValidateAbstract(DefaultCodespace, sup)
+	if err != nil {
+		return err
+	}
+	return sup.Plan.ValidateBasic()
+}
+
+func (sup SoftwareUpgradeProposal) String() string {
+	return fmt.Sprintf(`Software Upgrade Proposal:
+  Title:       %s
+  Description: %s
+  Name:        %s
+  Height:      %d
+  Info:        %s
+`, sup.Title, sup.Description, sup.Plan.Name, sup.Plan.Height, sup.Plan.Info)
+}
+
+// CancelSoftwareUpgradeProposal cancels the currently scheduled upgrade plan
+type CancelSoftwareUpgradeProposal struct {
+	Title       string `json:"title" yaml:"title"`
+	Description string `json:"description" yaml:"description"`
+}
+
+func NewCancelSoftwareUpgradeProposal(title, description string) CancelSoftwareUpgradeProposal {
+	return CancelSoftwareUpgradeProposal{
+		Title:       title,
+		Description: description,
+	}
+}
+
+func (csup CancelSoftwareUpgradeProposal) GetTitle() string       { return csup.Title }
+func (csup CancelSoftwareUpgradeProposal) GetDescription() string { return csup.Description }
+func (csup CancelSoftwareUpgradeProposal) ProposalRoute() string  { return RouterKey }
+func (csup CancelSoftwareUpgradeProposal) ProposalType() string   { return ProposalTypeCancelSoftwareUpgrade }
+
+func (csup CancelSoftwareUpgradeProposal) ValidateBasic() sdk.Error {
+	return govtypes.ValidateAbstract(DefaultCodespace, csup)
+}
+
+func (csup CancelSoftwareUpgradeProposal) String() string {
+	return fmt.Sprintf(`Cancel Software Upgrade Proposal:
+  Title:       %s
+  Description: %s
+`, csup.Title, csup.Description)
+}
@@ -0,0 +1,17 @@
+package types
+
+const (
+	QueryCurrent = "current"
+	QueryApplied = "applied"
+)
+
+// Params for query 'custom/upgrade/applied'
+type QueryAppliedParams struct {
+	Name string
+}
+
+func NewQueryAppliedParams(name string) QueryAppliedParams {
+	return QueryAppliedParams{
+		Name: name,
+	}
+}
@@ -0,0 +1,32 @@
+package whitelist
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// EndBlocker removes the retired validators whose self-delegation completed unbonding from the whitelist
+func EndBlocker(ctx sdk.Context, keeper Keeper) {
+	var completed []Retirement
+	keeper.IterateRetirements(ctx, func(retirement Retirement) bool {
+		if !retirement.CompletionTime.After(ctx.BlockHeader().Time) {
+			completed = append(completed, retirement)
+		}
+		return false
+	})
+
+	for _, retirement := range completed {
+		keeper.RemoveFromWhitelist(ctx, retirement.ValidatorAddress)
+		keeper.DeleteRetirement(ctx, retirement.ValidatorAddress)
+
+		keeper.Logger(ctx).Info(fmt.Sprintf("validator %s retired", retirement.ValidatorAddress))
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				EventTypeCompleteRetirement,
+				sdk.NewAttribute(AttributeKeyValidator, retirement.ValidatorAddress.String()),
+			),
+		)
+	}
+}
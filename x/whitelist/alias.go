@@ -5,34 +5,82 @@ import (
 )
 
 const (
-	ModuleName     = types.ModuleName
-	StoreKey       = types.StoreKey
-	QuerierRoute   = types.QuerierRoute
-	RouterKey      = types.RouterKey
-	QueryApprover  = types.QueryApprover
-	QueryWhitelist = types.QueryWhitelist
+	ModuleName            = types.ModuleName
+	StoreKey              = types.StoreKey
+	QuerierRoute          = types.QuerierRoute
+	RouterKey             = types.RouterKey
+	QueryApprover         = types.QueryApprover
+	QueryWhitelist        = types.QueryWhitelist
+	QueryParams           = types.QueryParams
+	QueryCommissionBounds = types.QueryCommissionBounds
+	QueryDelegationRoom   = types.QueryDelegationRoom
+	QueryMoniker          = types.QueryMoniker
+	QueryRetirements      = types.QueryRetirements
 )
 
 var (
-	ModuleCdc                   = types.ModuleCdc
-	NewMsgSetWhitelist          = types.NewMsgSetWhitelist
-	ErrInvalidApprover          = types.ErrInvalidApprover
-	ErrValidatorNotInWEhitelist = types.ErrValidatorNotInWEhitelist
-	KeyApprover                 = types.KeyApprover
-	DefaultParams               = types.DefaultParams
-	DefaultGenesisState         = types.DefaultGenesisState
-	DefaultCodespace            = types.DefaultCodespace
-	ValidateGenesis             = types.ValidateGenesis
-	WhitelistKey                = types.WhitelistKey
-	EventTypeSetWhitelist       = types.EventTypeSetWhitelist
-	AttributeKeyWhitelist       = types.AttributeKeyWhitelist
-	AttributeValueCategory      = types.AttributeValueCategory
-	RegisterCodec               = types.RegisterCodec
+	ModuleCdc                    = types.ModuleCdc
+	NewMsgSetWhitelist           = types.NewMsgSetWhitelist
+	NewMsgRetireValidator        = types.NewMsgRetireValidator
+	NewRetirement                = types.NewRetirement
+	ErrInvalidApprover           = types.ErrInvalidApprover
+	ErrValidatorNotInWEhitelist  = types.ErrValidatorNotInWEhitelist
+	ErrMinSelfDelegationTooLow   = types.ErrMinSelfDelegationTooLow
+	ErrCommissionOutOfBounds     = types.ErrCommissionOutOfBounds
+	ErrDelegationCapExceeded     = types.ErrDelegationCapExceeded
+	ErrDelegationNotInWhitelist  = types.ErrDelegationNotInWhitelist
+	ErrMonikerTaken              = types.ErrMonikerTaken
+	ErrIdentityTaken             = types.ErrIdentityTaken
+	ErrValidatorRetiring         = types.ErrValidatorRetiring
+	KeyApprover                  = types.KeyApprover
+	KeyMinSelfDelegation         = types.KeyMinSelfDelegation
+	KeyMinCommissionRate         = types.KeyMinCommissionRate
+	KeyMaxCommissionRate         = types.KeyMaxCommissionRate
+	KeyMaxCommissionChangeRate   = types.KeyMaxCommissionChangeRate
+	KeyMaxValidatorTokens        = types.KeyMaxValidatorTokens
+	KeyMaxValidatorPowerRatio    = types.KeyMaxValidatorPowerRatio
+	KeyRestrictDelegation        = types.KeyRestrictDelegation
+	KeyUniqueIdentity            = types.KeyUniqueIdentity
+	NewCommissionBounds          = types.NewCommissionBounds
+	NewDelegationCap             = types.NewDelegationCap
+	NewQueryDelegationRoomParams = types.NewQueryDelegationRoomParams
+	NewQueryMonikerParams        = types.NewQueryMonikerParams
+	DefaultParams                = types.DefaultParams
+	DefaultGenesisState          = types.DefaultGenesisState
+	DefaultCodespace             = types.DefaultCodespace
+	ValidateGenesis              = types.ValidateGenesis
+	WhitelistKey                 = types.WhitelistKey
+	MonikerKeyPrefix             = types.MonikerKeyPrefix
+	IdentityKeyPrefix            = types.IdentityKeyPrefix
+	MonikerKey                   = types.MonikerKey
+	IdentityKey                  = types.IdentityKey
+	RetirementKeyPrefix          = types.RetirementKeyPrefix
+	RetirementKey                = types.RetirementKey
+	NormalizeDescriptionField    = types.NormalizeDescriptionField
+	EventTypeSetWhitelist        = types.EventTypeSetWhitelist
+	EventTypeRetireValidator     = types.EventTypeRetireValidator
+	EventTypeValidatorRetiring   = types.EventTypeValidatorRetiring
+	EventTypeCompleteRetirement  = types.EventTypeCompleteRetirement
+	AttributeKeyWhitelist        = types.AttributeKeyWhitelist
+	AttributeKeyValidator        = types.AttributeKeyValidator
+	AttributeKeyDelegator        = types.AttributeKeyDelegator
+	AttributeKeyCompletionTime   = types.AttributeKeyCompletionTime
+	AttributeValueCategory       = types.AttributeValueCategory
+	RegisterCodec                = types.RegisterCodec
 )
 
 type (
-	MsgSetWhitelist = types.MsgSetWhitelist
-	Whitelist       = types.Whitelist
-	Params          = types.Params
-	GenesisState    = types.GenesisState
+	MsgSetWhitelist           = types.MsgSetWhitelist
+	MsgRetireValidator        = types.MsgRetireValidator
+	Whitelist                 = types.Whitelist
+	Retirement                = types.Retirement
+	Retirements               = types.Retirements
+	Params                    = types.Params
+	CommissionBounds          = types.CommissionBounds
+	DelegationCap             = types.DelegationCap
+	DelegationRoom            = types.DelegationRoom
+	StakingKeeper             = types.StakingKeeper
+	QueryDelegationRoomParams = types.QueryDelegationRoomParams
+	QueryMonikerParams        = types.QueryMonikerParams
+	GenesisState              = types.GenesisState
 )
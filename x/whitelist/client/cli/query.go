@@ -25,6 +25,11 @@ func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	whitelistQueryCmd.AddCommand(client.GetCommands(
 		GetCmdQueryWhitelist(queryRoute, cdc),
 		GetCmdQueryApprover(queryRoute, cdc),
+		GetCmdQueryParams(queryRoute, cdc),
+		GetCmdQueryCommissionBounds(queryRoute, cdc),
+		GetCmdQueryDelegationRoom(queryRoute, cdc),
+		GetCmdQueryValidatorByMoniker(queryRoute, cdc),
+		GetCmdQueryRetirements(queryRoute, cdc),
 	)...)
 
 	return whitelistQueryCmd
@@ -86,3 +91,145 @@ $ likecli query whitelist approver
 		},
 	}
 }
+
+// GetCmdQueryParams implements the whitelist params query command.
+func GetCmdQueryParams(storeName string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "params",
+		Short: "Query the parameters of the whitelist module",
+		Long: strings.TrimSpace(`Query the parameters of the whitelist module, including the rules on validators:
+
+$ likecli query whitelist params
+`),
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.Query(fmt.Sprintf("custom/%s/%s", storeName, types.QueryParams))
+			if err != nil {
+				return err
+			}
+
+			var params types.Params
+			cdc.MustUnmarshalJSON(res, &params)
+			return cliCtx.PrintOutput(params)
+		},
+	}
+}
+
+// GetCmdQueryCommissionBounds implements the validator commission bounds query command.
+func GetCmdQueryCommissionBounds(storeName string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "commission-bounds",
+		Short: "Query the bounds on the commission of validators",
+		Long: strings.TrimSpace(`Query the bounds on the commission rate, max commission rate and max commission change rate of
+validators, which are checked when creating or editing validators:
+
+$ likecli query whitelist commission-bounds
+`),
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.Query(fmt.Sprintf("custom/%s/%s", storeName, types.QueryCommissionBounds))
+			if err != nil {
+				return err
+			}
+
+			var bounds types.CommissionBounds
+			cdc.MustUnmarshalJSON(res, &bounds)
+			return cliCtx.PrintOutput(bounds)
+		},
+	}
+}
+
+// GetCmdQueryDelegationRoom implements the query command for the delegation room of a validator.
+func GetCmdQueryDelegationRoom(storeName string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "delegation-room [validator-addr]",
+		Short: "Query the amount of tokens which can still be delegated to a validator",
+		Long: strings.TrimSpace(`Query the tokens of a validator, its limit under the delegation cap and the amount of tokens which
+can still be delegated or redelegated to it:
+
+$ likecli query whitelist delegation-room cosmosvaloper1...
+`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			validatorAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			bz, err := cdc.MarshalJSON(types.NewQueryDelegationRoomParams(validatorAddr))
+			if err != nil {
+				return err
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", storeName, types.QueryDelegationRoom), bz)
+			if err != nil {
+				return err
+			}
+
+			var room types.DelegationRoom
+			cdc.MustUnmarshalJSON(res, &room)
+			return cliCtx.PrintOutput(room)
+		},
+	}
+}
+
+// GetCmdQueryValidatorByMoniker implements the query command for the operator address of a validator by its moniker.
+func GetCmdQueryValidatorByMoniker(storeName string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "validator-by-moniker [moniker]",
+		Short: "Query the operator address of the validator using a moniker",
+		Long: strings.TrimSpace(`Query the operator address of the validator using a moniker, which is matched case-insensitively:
+
+$ likecli query whitelist validator-by-moniker "My Validator"
+`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			bz, err := cdc.MarshalJSON(types.NewQueryMonikerParams(args[0]))
+			if err != nil {
+				return err
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", storeName, types.QueryMoniker), bz)
+			if err != nil {
+				return err
+			}
+
+			validatorAddr := sdk.ValAddress{}
+			cdc.MustUnmarshalJSON(res, &validatorAddr)
+			return cliCtx.PrintOutput(validatorAddr)
+		},
+	}
+}
+
+// GetCmdQueryRetirements implements the query command for the retiring validators.
+func GetCmdQueryRetirements(storeName string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "retirements",
+		Short: "Query the retiring validators",
+		Long: strings.TrimSpace(`Query the retiring validators, and the time their self-delegation completes unbonding and they are
+removed from the whitelist:
+
+$ likecli query whitelist retirements
+`),
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.Query(fmt.Sprintf("custom/%s/%s", storeName, types.QueryRetirements))
+			if err != nil {
+				return err
+			}
+
+			var retirements types.Retirements
+			cdc.MustUnmarshalJSON(res, &retirements)
+			return cliCtx.PrintOutput(retirements)
+		},
+	}
+}
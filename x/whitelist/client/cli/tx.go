@@ -1,6 +1,8 @@
 package cli
 
 import (
+	"strings"
+
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -24,6 +26,7 @@ func GetTxCmd(storeKey string, cdc *codec.Codec) *cobra.Command {
 
 	whitelistTxCmd.AddCommand(client.PostCommands(
 		GetCmdSetWhitelist(cdc),
+		GetCmdRetireValidator(cdc),
 	)...)
 
 	return whitelistTxCmd
@@ -57,3 +60,30 @@ func GetCmdSetWhitelist(cdc *codec.Codec) *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdRetireValidator implements the retire validator command
+func GetCmdRetireValidator(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "retire",
+		Short: "retire the validator operated by the sender",
+		Long: strings.TrimSpace(`Unbond the self-delegation of the validator operated by the sender, which is removed from the whitelist
+when the unbonding completes:
+
+$ likecli tx whitelist retire --from mykey
+`),
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			valAddr := sdk.ValAddress(cliCtx.GetFromAddress())
+
+			msg := types.NewMsgRetireValidator(valAddr)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.MarkFlagRequired(client.FlagFrom)
+
+	return cmd
+}
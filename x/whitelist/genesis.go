@@ -8,6 +8,11 @@ import (
 func InitGenesis(ctx sdk.Context, keeper Keeper, genesisState GenesisState) []abci.ValidatorUpdate {
 	keeper.SetParams(ctx, genesisState.Params)
 	keeper.SetWhitelist(ctx, genesisState.Whitelist)
+	// the moniker and identity registry is derived from the validators imported by the staking genesis
+	keeper.RebuildDescriptionRegistry(ctx)
+	for _, retirement := range genesisState.Retirements {
+		keeper.SetRetirement(ctx, retirement)
+	}
 	return nil
 }
 
@@ -15,7 +20,8 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) GenesisState {
 	params := keeper.GetParams(ctx)
 	whitelist := keeper.GetWhitelist(ctx)
 	return GenesisState{
-		Params:    params,
-		Whitelist: whitelist,
+		Params:      params,
+		Whitelist:   whitelist,
+		Retirements: keeper.GetRetirements(ctx),
 	}
 }
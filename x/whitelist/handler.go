@@ -3,6 +3,7 @@ package whitelist
 import (
 	"encoding/json"
 	"fmt"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/staking"
@@ -13,6 +14,8 @@ func NewHandler(keeper Keeper) sdk.Handler {
 		switch msg := msg.(type) {
 		case MsgSetWhitelist:
 			return handleMsgSetWhitelist(ctx, msg, keeper)
+		case MsgRetireValidator:
+			return handleMsgRetireValidator(ctx, msg, keeper)
 		default:
 			errMsg := fmt.Sprintf("unrecognized whitelist message type: %T", msg)
 			return sdk.ErrUnknownRequest(errMsg).Result()
@@ -45,6 +48,53 @@ func handleMsgSetWhitelist(ctx sdk.Context, msg MsgSetWhitelist, keeper Keeper)
 	return sdk.Result{Events: ctx.EventManager().Events()}
 }
 
+func handleMsgRetireValidator(ctx sdk.Context, msg MsgRetireValidator, keeper Keeper) sdk.Result {
+	if _, found := keeper.GetRetirement(ctx, msg.ValidatorAddress); found {
+		return ErrValidatorRetiring(keeper.Codespace(), msg.ValidatorAddress).Result()
+	}
+	if _, found := keeper.sk.GetValidator(ctx, msg.ValidatorAddress); !found {
+		return staking.ErrNoValidatorFound(keeper.Codespace()).Result()
+	}
+
+	// validators without self-delegation are removed from the whitelist in the EndBlocker of the current block
+	delAddr := sdk.AccAddress(msg.ValidatorAddress)
+	completionTime := ctx.BlockHeader().Time
+	delegation, found := keeper.sk.GetDelegation(ctx, delAddr, msg.ValidatorAddress)
+	if found {
+		var err sdk.Error
+		completionTime, err = keeper.sk.Undelegate(ctx, delAddr, msg.ValidatorAddress, delegation.Shares)
+		if err != nil {
+			return err.Result()
+		}
+	}
+	keeper.SetRetirement(ctx, NewRetirement(msg.ValidatorAddress, completionTime))
+
+	completionTimeStr := completionTime.Format(time.RFC3339)
+	events := sdk.Events{
+		sdk.NewEvent(
+			EventTypeRetireValidator,
+			sdk.NewAttribute(AttributeKeyValidator, msg.ValidatorAddress.String()),
+			sdk.NewAttribute(AttributeKeyCompletionTime, completionTimeStr),
+		),
+	}
+	for _, delegation := range keeper.sk.GetValidatorDelegations(ctx, msg.ValidatorAddress) {
+		events = append(events, sdk.NewEvent(
+			EventTypeValidatorRetiring,
+			sdk.NewAttribute(AttributeKeyValidator, msg.ValidatorAddress.String()),
+			sdk.NewAttribute(AttributeKeyDelegator, delegation.DelegatorAddress.String()),
+			sdk.NewAttribute(AttributeKeyCompletionTime, completionTimeStr),
+		))
+	}
+	events = append(events, sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+		sdk.NewAttribute(sdk.AttributeKeySender, delAddr.String()),
+	))
+	ctx.EventManager().EmitEvents(events)
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
 func WrapStakingHandler(keeper Keeper, stakingHandler sdk.Handler) sdk.Handler {
 	return func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
 		ctx = ctx.WithEventManager(sdk.NewEventManager())
@@ -54,20 +104,124 @@ func WrapStakingHandler(keeper Keeper, stakingHandler sdk.Handler) sdk.Handler {
 			if result.Code != 0 {
 				return result
 			}
+			result = checkMinSelfDelegation(ctx, keeper, &msg.MinSelfDelegation)
+			if result.Code != 0 {
+				return result
+			}
+			commission := msg.Commission
+			err := keeper.CommissionBounds(ctx).CheckCommission(keeper.Codespace(), commission.Rate, commission.MaxRate, commission.MaxChangeRate)
+			if err != nil {
+				return err.Result()
+			}
+			err = keeper.CheckDescription(ctx, msg.ValidatorAddress, msg.Description)
+			if err != nil {
+				return err.Result()
+			}
+			result = stakingHandler(ctx, msg)
+			if result.IsOK() {
+				keeper.RegisterDescription(ctx, msg.ValidatorAddress, msg.Description)
+			}
+			return result
+		case staking.MsgEditValidator:
+			result := checkMinSelfDelegation(ctx, keeper, msg.MinSelfDelegation)
+			if result.Code != 0 {
+				return result
+			}
+			if msg.CommissionRate != nil {
+				err := keeper.CommissionBounds(ctx).CheckRate(keeper.Codespace(), *msg.CommissionRate)
+				if err != nil {
+					return err.Result()
+				}
+			}
+			return handleEditValidatorDescription(ctx, keeper, msg, stakingHandler)
+		case staking.MsgDelegate:
+			result := checkDelegationWhitelist(ctx, keeper, msg.ValidatorAddress)
+			if result.Code != 0 {
+				return result
+			}
+			result = checkDelegationCap(ctx, keeper, msg.ValidatorAddress, msg.Amount.Amount)
+			if result.Code != 0 {
+				return result
+			}
+		case staking.MsgBeginRedelegate:
+			if !msg.ValidatorSrcAddress.Equals(msg.ValidatorDstAddress) {
+				result := checkDelegationWhitelist(ctx, keeper, msg.ValidatorDstAddress)
+				if result.Code != 0 {
+					return result
+				}
+				result = checkDelegationCap(ctx, keeper, msg.ValidatorDstAddress, msg.Amount.Amount)
+				if result.Code != 0 {
+					return result
+				}
+			}
 		}
 		return stakingHandler(ctx, msg)
 	}
 }
 
 func checkWhitelist(ctx sdk.Context, keeper Keeper, msg staking.MsgCreateValidator) sdk.Result {
-	whitelist := keeper.GetWhitelist(ctx)
-	if len(whitelist) > 0 {
-		for _, v := range whitelist {
-			if msg.ValidatorAddress.Equals(v) {
-				return sdk.Result{Code: 0}
-			}
-		}
+	if !keeper.IsWhitelisted(ctx, msg.ValidatorAddress) {
 		return ErrValidatorNotInWEhitelist(keeper.Codespace()).Result()
 	}
 	return sdk.Result{}
 }
+
+// checkDelegationWhitelist checks if the validator receiving the delegation is in the whitelist, when delegation is
+// restricted by the params
+func checkDelegationWhitelist(ctx sdk.Context, keeper Keeper, valAddr sdk.ValAddress) sdk.Result {
+	if !keeper.RestrictDelegation(ctx) {
+		return sdk.Result{}
+	}
+	if !keeper.IsWhitelisted(ctx, valAddr) {
+		return ErrDelegationNotInWhitelist(keeper.Codespace(), valAddr).Result()
+	}
+	return sdk.Result{}
+}
+
+// checkMinSelfDelegation checks the MinSelfDelegation set by the message, which is nil if the message does not change it
+func checkMinSelfDelegation(ctx sdk.Context, keeper Keeper, minSelfDelegation *sdk.Int) sdk.Result {
+	if minSelfDelegation == nil {
+		return sdk.Result{}
+	}
+	min := keeper.MinSelfDelegation(ctx)
+	if minSelfDelegation.LT(min) {
+		return ErrMinSelfDelegationTooLow(keeper.Codespace(), min).Result()
+	}
+	return sdk.Result{}
+}
+
+// checkDelegationCap checks if the validator could receive the amount of tokens under the delegation cap, non-existing
+// validators are left to the staking handler
+func checkDelegationCap(ctx sdk.Context, keeper Keeper, valAddr sdk.ValAddress, amount sdk.Int) sdk.Result {
+	room, found := keeper.GetDelegationRoom(ctx, valAddr)
+	if !found || !room.Capped {
+		return sdk.Result{}
+	}
+	if amount.GT(room.Room) {
+		return ErrDelegationCapExceeded(keeper.Codespace(), valAddr, room.Room).Result()
+	}
+	return sdk.Result{}
+}
+
+// handleEditValidatorDescription checks the uniqueness of the edited description and updates the registry, invalid
+// messages are left to the staking handler
+func handleEditValidatorDescription(ctx sdk.Context, keeper Keeper, msg staking.MsgEditValidator, stakingHandler sdk.Handler) sdk.Result {
+	validator, found := keeper.sk.GetValidator(ctx, msg.ValidatorAddress)
+	if !found {
+		return stakingHandler(ctx, msg)
+	}
+	description, err := validator.Description.UpdateDescription(msg.Description)
+	if err != nil {
+		return stakingHandler(ctx, msg)
+	}
+	err = keeper.CheckDescription(ctx, msg.ValidatorAddress, description)
+	if err != nil {
+		return err.Result()
+	}
+	result := stakingHandler(ctx, msg)
+	if result.IsOK() {
+		keeper.UnregisterDescription(ctx, msg.ValidatorAddress, validator.Description)
+		keeper.RegisterDescription(ctx, msg.ValidatorAddress, description)
+	}
+	return result
+}
@@ -0,0 +1,322 @@
+package whitelist
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+)
+
+func TestCreateValidatorInWhitelist(t *testing.T) {
+	input, keeper, handler := createTestInput(t)
+	ctx := input.Ctx
+
+	// any validator can be created with an empty whitelist
+	createValidator(t, input, handler, createValidatorMsg(0, 10))
+
+	keeper.SetWhitelist(ctx, Whitelist{sdk.ValAddress(addrs[1])})
+	createValidator(t, input, handler, createValidatorMsg(1, 10))
+	result := handler(ctx, createValidatorMsg(2, 10))
+	requireError(t, result, DefaultCodespace, staking.CodeInvalidValidator)
+	_, found := input.StakingKeeper.GetValidator(ctx, sdk.ValAddress(addrs[2]))
+	require.False(t, found)
+}
+
+func TestMinSelfDelegation(t *testing.T) {
+	input, keeper, handler := createTestInput(t)
+	ctx := input.Ctx
+	setParams(ctx, keeper, func(params *Params) {
+		params.MinSelfDelegation = tokens(5)
+	})
+
+	msg := createValidatorMsg(0, 10)
+	msg.MinSelfDelegation = tokens(5).SubRaw(1)
+	result := handler(ctx, msg)
+	requireError(t, result, DefaultCodespace, staking.CodeInvalidInput)
+
+	msg.MinSelfDelegation = tokens(5)
+	createValidator(t, input, handler, msg)
+
+	// validators created before raising the param can only raise their MinSelfDelegation to the new minimum
+	setParams(ctx, keeper, func(params *Params) {
+		params.MinSelfDelegation = tokens(8)
+	})
+	minSelfDelegation := tokens(6)
+	result = handler(ctx, editValidatorMsg(0, nil, &minSelfDelegation))
+	requireError(t, result, DefaultCodespace, staking.CodeInvalidInput)
+
+	// editing other fields is not affected
+	result = handler(ctx, staking.NewMsgEditValidator(msg.ValidatorAddress, description("renamed"), nil, nil))
+	require.True(t, result.IsOK(), result.Log)
+
+	minSelfDelegation = tokens(8)
+	result = handler(ctx, editValidatorMsg(0, nil, &minSelfDelegation))
+	require.True(t, result.IsOK(), result.Log)
+	validator, _ := input.StakingKeeper.GetValidator(ctx, msg.ValidatorAddress)
+	require.Equal(t, tokens(8), validator.MinSelfDelegation)
+}
+
+func TestMissingParams(t *testing.T) {
+	input, keeper, handler := createTestInput(t)
+	ctx := input.Ctx
+	deleteParams(
+		ctx, input, KeyMinSelfDelegation, KeyMinCommissionRate, KeyMaxCommissionRate, KeyMaxCommissionChangeRate,
+		KeyMaxValidatorTokens, KeyMaxValidatorPowerRatio, KeyRestrictDelegation,
+		KeyUniqueIdentity,
+	)
+	require.Equal(t, DefaultParams().String(), keeper.GetParams(ctx).String())
+
+	msg := createValidatorMsg(0, 10)
+	msg.MinSelfDelegation = tokens(5)
+	createValidator(t, input, handler, msg)
+	minSelfDelegation := tokens(8)
+	result := handler(ctx, editValidatorMsg(0, nil, &minSelfDelegation))
+	require.True(t, result.IsOK(), result.Log)
+	result = handler(ctx, delegateMsg(1, msg.ValidatorAddress, tokens(100)))
+	require.True(t, result.IsOK(), result.Log)
+}
+
+func TestParamsValidate(t *testing.T) {
+	require.NoError(t, DefaultParams().Validate())
+
+	params := DefaultParams()
+	params.MinSelfDelegation = sdk.ZeroInt()
+	require.Error(t, params.Validate())
+}
+
+func TestCommissionBounds(t *testing.T) {
+	input, keeper, handler := createTestInput(t)
+	ctx := input.Ctx
+	setParams(ctx, keeper, func(params *Params) {
+		params.MinCommissionRate = sdk.NewDecWithPrec(5, 2)
+		params.MaxCommissionRate = sdk.NewDecWithPrec(20, 2)
+		params.MaxCommissionChangeRate = sdk.NewDecWithPrec(1, 2)
+	})
+
+	tests := []struct {
+		name       string
+		commission staking.CommissionRates
+		valid      bool
+	}{
+		{"rate below min", staking.NewCommissionRates(sdk.NewDecWithPrec(4, 2), sdk.NewDecWithPrec(20, 2), sdk.NewDecWithPrec(1, 2)), false},
+		{"rate above max", staking.NewCommissionRates(sdk.NewDecWithPrec(21, 2), sdk.NewDecWithPrec(21, 2), sdk.NewDecWithPrec(1, 2)), false},
+		{"max rate above max", staking.NewCommissionRates(sdk.NewDecWithPrec(10, 2), sdk.NewDecWithPrec(21, 2), sdk.NewDecWithPrec(1, 2)), false},
+		{"max change rate above max", staking.NewCommissionRates(sdk.NewDecWithPrec(10, 2), sdk.NewDecWithPrec(20, 2), sdk.NewDecWithPrec(2, 2)), false},
+		{"within bounds", staking.NewCommissionRates(sdk.NewDecWithPrec(10, 2), sdk.NewDecWithPrec(20, 2), sdk.NewDecWithPrec(1, 2)), true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			msg := createValidatorMsg(0, 10)
+			msg.Commission = test.commission
+			result := handler(ctx, msg)
+			if test.valid {
+				require.True(t, result.IsOK(), result.Log)
+			} else {
+				requireError(t, result, DefaultCodespace, staking.CodeInvalidValidator)
+			}
+		})
+	}
+	staking.EndBlocker(ctx, input.StakingKeeper)
+
+	// the staking module only allows changing the commission rate once a day
+	ctx = input.NextBlock(25 * time.Hour)
+	rate := sdk.NewDecWithPrec(4, 2)
+	result := handler(ctx, editValidatorMsg(0, &rate, nil))
+	requireError(t, result, DefaultCodespace, staking.CodeInvalidValidator)
+
+	rate = sdk.NewDecWithPrec(9, 2)
+	result = handler(ctx, editValidatorMsg(0, &rate, nil))
+	require.True(t, result.IsOK(), result.Log)
+	validator, _ := input.StakingKeeper.GetValidator(ctx, sdk.ValAddress(addrs[0]))
+	require.Equal(t, rate, validator.Commission.Rate)
+}
+
+func TestQueryCommissionBounds(t *testing.T) {
+	input, keeper, _ := createTestInput(t)
+	ctx := input.Ctx
+	bounds := NewCommissionBounds(sdk.NewDecWithPrec(5, 2), sdk.NewDecWithPrec(20, 2), sdk.NewDecWithPrec(1, 2))
+	setParams(ctx, keeper, func(params *Params) {
+		params.MinCommissionRate = bounds.MinRate
+		params.MaxCommissionRate = bounds.MaxRate
+		params.MaxCommissionChangeRate = bounds.MaxChangeRate
+	})
+
+	res, err := NewQuerier(keeper)(ctx, []string{QueryCommissionBounds}, abci.RequestQuery{})
+	require.Nil(t, err)
+	var queried CommissionBounds
+	require.NoError(t, ModuleCdc.UnmarshalJSON(res, &queried))
+	require.Equal(t, bounds, queried)
+}
+
+func TestCommissionBoundsValidate(t *testing.T) {
+	tests := []struct {
+		name   string
+		bounds CommissionBounds
+		valid  bool
+	}{
+		{"default", DefaultParams().CommissionBounds(), true},
+		{"min above max", NewCommissionBounds(sdk.NewDecWithPrec(3, 1), sdk.NewDecWithPrec(2, 1), sdk.OneDec()), false},
+		{"negative min", NewCommissionBounds(sdk.NewDec(-1), sdk.OneDec(), sdk.OneDec()), false},
+		{"max above 1", NewCommissionBounds(sdk.ZeroDec(), sdk.NewDec(2), sdk.OneDec()), false},
+		{"nil max change rate", NewCommissionBounds(sdk.ZeroDec(), sdk.OneDec(), sdk.Dec{}), false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.bounds.Validate()
+			if test.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func delegateMsg(i int, valAddr sdk.ValAddress, amount sdk.Int) staking.MsgDelegate {
+	return staking.NewMsgDelegate(addrs[i], valAddr, sdk.NewCoin(sdk.DefaultBondDenom, amount))
+}
+
+func TestDelegationCap(t *testing.T) {
+	input, keeper, handler := createTestInput(t)
+	ctx := input.Ctx
+	valAddr0 := createValidator(t, input, handler, createValidatorMsg(0, 10))
+	valAddr1 := createValidator(t, input, handler, createValidatorMsg(1, 10))
+
+	// without a cap
+	result := handler(ctx, delegateMsg(2, valAddr1, tokens(5)))
+	require.True(t, result.IsOK(), result.Log)
+
+	setParams(ctx, keeper, func(params *Params) {
+		params.MaxValidatorTokens = tokens(15)
+	})
+	result = handler(ctx, delegateMsg(3, valAddr0, tokens(6)))
+	requireError(t, result, DefaultCodespace, staking.CodeInvalidDelegation)
+	require.Contains(t, result.Log, tokens(5).String())
+	result = handler(ctx, delegateMsg(3, valAddr0, tokens(5)))
+	require.True(t, result.IsOK(), result.Log)
+	result = handler(ctx, delegateMsg(3, valAddr0, sdk.OneInt()))
+	requireError(t, result, DefaultCodespace, staking.CodeInvalidDelegation)
+
+	// the cap applies to the destination of redelegations
+	result = handler(ctx, staking.NewMsgBeginRedelegate(addrs[3], valAddr0, valAddr1, sdk.NewCoin(sdk.DefaultBondDenom, tokens(1))))
+	requireError(t, result, DefaultCodespace, staking.CodeInvalidDelegation)
+	result = handler(ctx, staking.NewMsgBeginRedelegate(addrs[2], valAddr1, valAddr0, sdk.NewCoin(sdk.DefaultBondDenom, tokens(1))))
+	requireError(t, result, DefaultCodespace, staking.CodeInvalidDelegation)
+
+	// the lower of the absolute cap and the power ratio cap applies, total bonded tokens are now 30
+	setParams(ctx, keeper, func(params *Params) {
+		params.MaxValidatorTokens = tokens(20)
+		params.MaxValidatorPowerRatio = sdk.NewDecWithPrec(6, 1)
+	})
+	room, found := keeper.GetDelegationRoom(ctx, valAddr0)
+	require.True(t, found)
+	require.True(t, room.Capped)
+	require.Equal(t, tokens(18), room.Limit)
+	require.Equal(t, tokens(3), room.Room)
+	result = handler(ctx, delegateMsg(4, valAddr0, tokens(3)))
+	require.True(t, result.IsOK(), result.Log)
+
+	// unknown validators are rejected by the staking handler
+	result = handler(ctx, delegateMsg(4, sdk.ValAddress(addrs[5]), tokens(1)))
+	requireError(t, result, staking.DefaultCodespace, staking.CodeInvalidValidator)
+}
+
+func TestQueryDelegationRoom(t *testing.T) {
+	input, keeper, handler := createTestInput(t)
+	ctx := input.Ctx
+	valAddr := createValidator(t, input, handler, createValidatorMsg(0, 10))
+	querier := NewQuerier(keeper)
+	query := func(valAddr sdk.ValAddress) ([]byte, sdk.Error) {
+		data := ModuleCdc.MustMarshalJSON(NewQueryDelegationRoomParams(valAddr))
+		return querier(ctx, []string{QueryDelegationRoom}, abci.RequestQuery{Data: data})
+	}
+
+	res, err := query(valAddr)
+	require.Nil(t, err)
+	var room DelegationRoom
+	require.NoError(t, ModuleCdc.UnmarshalJSON(res, &room))
+	require.False(t, room.Capped)
+	require.Equal(t, tokens(10), room.Tokens)
+
+	setParams(ctx, keeper, func(params *Params) {
+		params.MaxValidatorTokens = tokens(15)
+	})
+	res, err = query(valAddr)
+	require.Nil(t, err)
+	require.NoError(t, ModuleCdc.UnmarshalJSON(res, &room))
+	require.True(t, room.Capped)
+	require.Equal(t, tokens(15), room.Limit)
+	require.Equal(t, tokens(5), room.Room)
+
+	_, err = query(sdk.ValAddress(addrs[1]))
+	require.NotNil(t, err)
+	require.Equal(t, staking.CodeInvalidValidator, err.Code())
+}
+
+func TestDelegationCapLimit(t *testing.T) {
+	totalBondedTokens := sdk.NewInt(1000)
+	tests := []struct {
+		name   string
+		cap    DelegationCap
+		capped bool
+		limit  int64
+	}{
+		{"no cap", NewDelegationCap(sdk.ZeroInt(), sdk.ZeroDec()), false, 0},
+		{"absolute cap", NewDelegationCap(sdk.NewInt(300), sdk.ZeroDec()), true, 300},
+		{"power ratio cap", NewDelegationCap(sdk.ZeroInt(), sdk.NewDecWithPrec(2, 1)), true, 200},
+		{"lower absolute cap", NewDelegationCap(sdk.NewInt(100), sdk.NewDecWithPrec(2, 1)), true, 100},
+		{"lower power ratio cap", NewDelegationCap(sdk.NewInt(300), sdk.NewDecWithPrec(2, 1)), true, 200},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.NoError(t, test.cap.Validate())
+			limit, capped := test.cap.Limit(totalBondedTokens)
+			require.Equal(t, test.capped, capped)
+			if capped {
+				require.Equal(t, sdk.NewInt(test.limit), limit)
+			}
+		})
+	}
+
+	require.Error(t, NewDelegationCap(sdk.NewInt(-1), sdk.ZeroDec()).Validate())
+	require.Error(t, NewDelegationCap(sdk.ZeroInt(), sdk.NewDec(2)).Validate())
+}
+
+func TestRestrictDelegation(t *testing.T) {
+	input, keeper, handler := createTestInput(t)
+	ctx := input.Ctx
+	valAddr0 := createValidator(t, input, handler, createValidatorMsg(0, 10))
+	valAddr1 := createValidator(t, input, handler, createValidatorMsg(1, 10))
+	result := handler(ctx, delegateMsg(2, valAddr1, tokens(5)))
+	require.True(t, result.IsOK(), result.Log)
+
+	// validator 1 is removed from the whitelist, but delegation is not restricted yet
+	keeper.SetWhitelist(ctx, Whitelist{valAddr0})
+	result = handler(ctx, delegateMsg(2, valAddr1, tokens(1)))
+	require.True(t, result.IsOK(), result.Log)
+
+	setParams(ctx, keeper, func(params *Params) {
+		params.RestrictDelegation = true
+	})
+	result = handler(ctx, delegateMsg(2, valAddr1, tokens(1)))
+	requireError(t, result, DefaultCodespace, staking.CodeInvalidValidator)
+	result = handler(ctx, delegateMsg(2, valAddr0, tokens(1)))
+	require.True(t, result.IsOK(), result.Log)
+
+	// delegations can still be moved away from validators not in the whitelist, but not towards them
+	result = handler(ctx, staking.NewMsgBeginRedelegate(addrs[2], valAddr0, valAddr1, sdk.NewCoin(sdk.DefaultBondDenom, tokens(1))))
+	requireError(t, result, DefaultCodespace, staking.CodeInvalidValidator)
+	result = handler(ctx, staking.NewMsgBeginRedelegate(addrs[2], valAddr1, valAddr0, sdk.NewCoin(sdk.DefaultBondDenom, tokens(1))))
+	require.True(t, result.IsOK(), result.Log)
+	result = handler(ctx, staking.NewMsgUndelegate(addrs[2], valAddr1, sdk.NewCoin(sdk.DefaultBondDenom, tokens(1))))
+	require.True(t, result.IsOK(), result.Log)
+
+	// an empty whitelist allows delegating to any validator
+	keeper.SetWhitelist(ctx, Whitelist{})
+	result = handler(ctx, delegateMsg(2, valAddr1, tokens(1)))
+	require.True(t, result.IsOK(), result.Log)
+}
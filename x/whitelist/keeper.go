@@ -1,6 +1,10 @@
 package whitelist
 
 import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/log"
+
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/params"
@@ -14,14 +18,16 @@ type Keeper struct {
 	storeKey   sdk.StoreKey
 	cdc        *codec.Codec
 	paramstore params.Subspace
+	sk         StakingKeeper
 	codespace  sdk.CodespaceType
 }
 
-func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, paramstore params.Subspace, codespace sdk.CodespaceType) Keeper {
+func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, paramstore params.Subspace, sk StakingKeeper, codespace sdk.CodespaceType) Keeper {
 	return Keeper{
 		storeKey:   key,
 		cdc:        cdc,
 		paramstore: paramstore.WithKeyTable(ParamKeyTable()),
+		sk:         sk,
 		codespace:  codespace,
 	}
 }
@@ -30,6 +36,10 @@ func (keeper Keeper) Codespace() sdk.CodespaceType {
 	return keeper.codespace
 }
 
+func (keeper Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", ModuleName))
+}
+
 func (keeper Keeper) GetWhitelist(ctx sdk.Context) (whitelist Whitelist) {
 	bz := ctx.KVStore(keeper.storeKey).Get(WhitelistKey)
 	if bz == nil {
@@ -44,6 +54,20 @@ func (keeper Keeper) SetWhitelist(ctx sdk.Context, whitelist Whitelist) {
 	ctx.KVStore(keeper.storeKey).Set(WhitelistKey, bz)
 }
 
+// IsWhitelisted returns true if the validator is in the whitelist, or if the whitelist is empty
+func (keeper Keeper) IsWhitelisted(ctx sdk.Context, valAddr sdk.ValAddress) bool {
+	whitelist := keeper.GetWhitelist(ctx)
+	if len(whitelist) == 0 {
+		return true
+	}
+	for _, v := range whitelist {
+		if valAddr.Equals(v) {
+			return true
+		}
+	}
+	return false
+}
+
 func ParamKeyTable() params.KeyTable {
 	return params.NewKeyTable().RegisterParamSet(&Params{})
 }
@@ -53,9 +77,99 @@ func (k Keeper) Approver(ctx sdk.Context) (res sdk.AccAddress) {
 	return
 }
 
+// MinSelfDelegation returns the default if the param was never set, since it was added after the chain started
+func (k Keeper) MinSelfDelegation(ctx sdk.Context) (res sdk.Int) {
+	res = DefaultParams().MinSelfDelegation
+	k.paramstore.GetIfExists(ctx, KeyMinSelfDelegation, &res)
+	return
+}
+
+func (k Keeper) MinCommissionRate(ctx sdk.Context) (res sdk.Dec) {
+	res = DefaultParams().MinCommissionRate
+	k.paramstore.GetIfExists(ctx, KeyMinCommissionRate, &res)
+	return
+}
+
+func (k Keeper) MaxCommissionRate(ctx sdk.Context) (res sdk.Dec) {
+	res = DefaultParams().MaxCommissionRate
+	k.paramstore.GetIfExists(ctx, KeyMaxCommissionRate, &res)
+	return
+}
+
+func (k Keeper) MaxCommissionChangeRate(ctx sdk.Context) (res sdk.Dec) {
+	res = DefaultParams().MaxCommissionChangeRate
+	k.paramstore.GetIfExists(ctx, KeyMaxCommissionChangeRate, &res)
+	return
+}
+
+func (k Keeper) CommissionBounds(ctx sdk.Context) CommissionBounds {
+	return NewCommissionBounds(k.MinCommissionRate(ctx), k.MaxCommissionRate(ctx), k.MaxCommissionChangeRate(ctx))
+}
+
+func (k Keeper) MaxValidatorTokens(ctx sdk.Context) (res sdk.Int) {
+	res = DefaultParams().MaxValidatorTokens
+	k.paramstore.GetIfExists(ctx, KeyMaxValidatorTokens, &res)
+	return
+}
+
+func (k Keeper) MaxValidatorPowerRatio(ctx sdk.Context) (res sdk.Dec) {
+	res = DefaultParams().MaxValidatorPowerRatio
+	k.paramstore.GetIfExists(ctx, KeyMaxValidatorPowerRatio, &res)
+	return
+}
+
+func (k Keeper) RestrictDelegation(ctx sdk.Context) (res bool) {
+	res = DefaultParams().RestrictDelegation
+	k.paramstore.GetIfExists(ctx, KeyRestrictDelegation, &res)
+	return
+}
+
+func (k Keeper) UniqueIdentity(ctx sdk.Context) (res bool) {
+	res = DefaultParams().UniqueIdentity
+	k.paramstore.GetIfExists(ctx, KeyUniqueIdentity, &res)
+	return
+}
+
+func (k Keeper) DelegationCap(ctx sdk.Context) DelegationCap {
+	return NewDelegationCap(k.MaxValidatorTokens(ctx), k.MaxValidatorPowerRatio(ctx))
+}
+
+// GetDelegationRoom returns the amount of tokens which can still be delegated to the validator, false if the validator
+// does not exist
+func (k Keeper) GetDelegationRoom(ctx sdk.Context, valAddr sdk.ValAddress) (room DelegationRoom, found bool) {
+	validator := k.sk.Validator(ctx, valAddr)
+	if validator == nil {
+		return room, false
+	}
+	tokens := validator.GetTokens()
+	limit, capped := k.DelegationCap(ctx).Limit(k.sk.TotalBondedTokens(ctx))
+	room = DelegationRoom{
+		ValidatorAddress: valAddr,
+		Tokens:           tokens,
+		Capped:           capped,
+		Limit:            sdk.ZeroInt(),
+		Room:             sdk.ZeroInt(),
+	}
+	if capped {
+		room.Limit = limit
+		if tokens.LT(limit) {
+			room.Room = limit.Sub(tokens)
+		}
+	}
+	return room, true
+}
+
 func (k Keeper) GetParams(ctx sdk.Context) Params {
 	return Params{
-		Approver: k.Approver(ctx),
+		Approver:                k.Approver(ctx),
+		MinSelfDelegation:       k.MinSelfDelegation(ctx),
+		MinCommissionRate:       k.MinCommissionRate(ctx),
+		MaxCommissionRate:       k.MaxCommissionRate(ctx),
+		MaxCommissionChangeRate: k.MaxCommissionChangeRate(ctx),
+		MaxValidatorTokens:      k.MaxValidatorTokens(ctx),
+		MaxValidatorPowerRatio:  k.MaxValidatorPowerRatio(ctx),
+		RestrictDelegation:      k.RestrictDelegation(ctx),
+		UniqueIdentity:          k.UniqueIdentity(ctx),
 	}
 }
 
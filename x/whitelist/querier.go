@@ -5,6 +5,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
 )
 
 func NewQuerier(k Keeper) sdk.Querier {
@@ -14,6 +15,16 @@ func NewQuerier(k Keeper) sdk.Querier {
 			return queryApprover(ctx, req, k)
 		case QueryWhitelist:
 			return queryWhitelist(ctx, req, k)
+		case QueryParams:
+			return queryParams(ctx, req, k)
+		case QueryCommissionBounds:
+			return queryCommissionBounds(ctx, req, k)
+		case QueryDelegationRoom:
+			return queryDelegationRoom(ctx, req, k)
+		case QueryMoniker:
+			return queryMoniker(ctx, req, k)
+		case QueryRetirements:
+			return queryRetirements(ctx, req, k)
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown whitelist query endpoint")
 		}
@@ -41,3 +52,79 @@ func queryWhitelist(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, s
 
 	return res, nil
 }
+
+func queryParams(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	params := k.GetParams(ctx)
+
+	res, err := codec.MarshalJSONIndent(ModuleCdc, params)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to JSON marshal result: %s", err.Error()))
+	}
+
+	return res, nil
+}
+
+func queryCommissionBounds(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	bounds := k.CommissionBounds(ctx)
+
+	res, err := codec.MarshalJSONIndent(ModuleCdc, bounds)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to JSON marshal result: %s", err.Error()))
+	}
+
+	return res, nil
+}
+
+func queryDelegationRoom(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params QueryDelegationRoomParams
+	err := ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	room, found := k.GetDelegationRoom(ctx, params.ValidatorAddress)
+	if !found {
+		return nil, staking.ErrNoValidatorFound(staking.DefaultCodespace)
+	}
+
+	res, err := codec.MarshalJSONIndent(ModuleCdc, room)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to JSON marshal result: %s", err.Error()))
+	}
+
+	return res, nil
+}
+
+func queryMoniker(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params QueryMonikerParams
+	err := ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	valAddr, found := k.GetValidatorByMoniker(ctx, params.Moniker)
+	if !found {
+		return nil, staking.ErrNoValidatorFound(staking.DefaultCodespace)
+	}
+
+	res, err := codec.MarshalJSONIndent(ModuleCdc, valAddr)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to JSON marshal result: %s", err.Error()))
+	}
+
+	return res, nil
+}
+
+func queryRetirements(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	retirements := k.GetRetirements(ctx)
+	if retirements == nil {
+		retirements = Retirements{}
+	}
+
+	res, err := codec.MarshalJSONIndent(ModuleCdc, retirements)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to JSON marshal result: %s", err.Error()))
+	}
+
+	return res, nil
+}
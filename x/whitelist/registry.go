@@ -0,0 +1,95 @@
+package whitelist
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+)
+
+func (keeper Keeper) getRegisteredValidator(ctx sdk.Context, key []byte) (valAddr sdk.ValAddress, found bool) {
+	bz := ctx.KVStore(keeper.storeKey).Get(key)
+	if bz == nil {
+		return nil, false
+	}
+	valAddr = sdk.ValAddress(bz)
+	// entries of removed validators are released
+	_, found = keeper.sk.GetValidator(ctx, valAddr)
+	return valAddr, found
+}
+
+// GetValidatorByMoniker returns the operator address of the validator using the moniker, case-insensitively
+func (keeper Keeper) GetValidatorByMoniker(ctx sdk.Context, moniker string) (valAddr sdk.ValAddress, found bool) {
+	return keeper.getRegisteredValidator(ctx, MonikerKey(moniker))
+}
+
+// GetValidatorByIdentity returns the operator address of the validator using the identity, case-insensitively
+func (keeper Keeper) GetValidatorByIdentity(ctx sdk.Context, identity string) (valAddr sdk.ValAddress, found bool) {
+	return keeper.getRegisteredValidator(ctx, IdentityKey(identity))
+}
+
+// CheckDescription checks if the moniker, and the identity if required by the params, is used by other validators
+func (keeper Keeper) CheckDescription(ctx sdk.Context, valAddr sdk.ValAddress, description staking.Description) sdk.Error {
+	owner, found := keeper.GetValidatorByMoniker(ctx, description.Moniker)
+	if found && !owner.Equals(valAddr) {
+		return ErrMonikerTaken(keeper.Codespace(), description.Moniker, owner)
+	}
+	if description.Identity == "" || !keeper.UniqueIdentity(ctx) {
+		return nil
+	}
+	owner, found = keeper.GetValidatorByIdentity(ctx, description.Identity)
+	if found && !owner.Equals(valAddr) {
+		return ErrIdentityTaken(keeper.Codespace(), description.Identity, owner)
+	}
+	return nil
+}
+
+// registers the validator on the key, unless the key is used by another validator, which is possible for identities
+// when the UniqueIdentity param is not set
+func (keeper Keeper) registerValidator(ctx sdk.Context, key []byte, valAddr sdk.ValAddress) {
+	if _, found := keeper.getRegisteredValidator(ctx, key); found {
+		return
+	}
+	ctx.KVStore(keeper.storeKey).Set(key, valAddr)
+}
+
+// RegisterDescription registers the moniker and the identity of the validator
+func (keeper Keeper) RegisterDescription(ctx sdk.Context, valAddr sdk.ValAddress, description staking.Description) {
+	keeper.registerValidator(ctx, MonikerKey(description.Moniker), valAddr)
+	if description.Identity != "" {
+		keeper.registerValidator(ctx, IdentityKey(description.Identity), valAddr)
+	}
+}
+
+// UnregisterDescription removes the moniker and the identity of the validator from the registry, if they are still
+// registered to the validator
+func (keeper Keeper) UnregisterDescription(ctx sdk.Context, valAddr sdk.ValAddress, description staking.Description) {
+	store := ctx.KVStore(keeper.storeKey)
+	keys := [][]byte{MonikerKey(description.Moniker)}
+	if description.Identity != "" {
+		keys = append(keys, IdentityKey(description.Identity))
+	}
+	for _, key := range keys {
+		if valAddr.Equals(sdk.ValAddress(store.Get(key))) {
+			store.Delete(key)
+		}
+	}
+}
+
+// RebuildDescriptionRegistry registers the descriptions of all existing validators, keeping the first validator on
+// duplicated monikers or identities
+func (keeper Keeper) RebuildDescriptionRegistry(ctx sdk.Context) {
+	store := ctx.KVStore(keeper.storeKey)
+	for _, prefix := range [][]byte{MonikerKeyPrefix, IdentityKeyPrefix} {
+		iterator := sdk.KVStorePrefixIterator(store, prefix)
+		var keys [][]byte
+		for ; iterator.Valid(); iterator.Next() {
+			keys = append(keys, iterator.Key())
+		}
+		iterator.Close()
+		for _, key := range keys {
+			store.Delete(key)
+		}
+	}
+	for _, validator := range keeper.sk.GetAllValidators(ctx) {
+		keeper.RegisterDescription(ctx, validator.OperatorAddress, validator.Description)
+	}
+}
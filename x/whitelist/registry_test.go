@@ -0,0 +1,114 @@
+package whitelist
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+)
+
+func createValidatorWithDescription(i int, description staking.Description) staking.MsgCreateValidator {
+	msg := createValidatorMsg(i, 10)
+	msg.Description = description
+	return msg
+}
+
+func TestUniqueMoniker(t *testing.T) {
+	input, keeper, handler := createTestInput(t)
+	ctx := input.Ctx
+	valAddr0 := createValidator(t, input, handler, createValidatorWithDescription(0, description("Alice")))
+	valAddr1 := createValidator(t, input, handler, createValidatorWithDescription(1, description("Bob")))
+
+	result := handler(ctx, createValidatorWithDescription(2, description(" alice ")))
+	requireError(t, result, DefaultCodespace, staking.CodeInvalidValidator)
+	result = handler(ctx, staking.NewMsgEditValidator(valAddr1, description("ALICE"), nil, nil))
+	requireError(t, result, DefaultCodespace, staking.CodeInvalidValidator)
+
+	// validators can change the case of their own moniker
+	result = handler(ctx, staking.NewMsgEditValidator(valAddr0, description("ALICE"), nil, nil))
+	require.True(t, result.IsOK(), result.Log)
+	valAddr, found := keeper.GetValidatorByMoniker(ctx, "alice")
+	require.True(t, found)
+	require.Equal(t, valAddr0, valAddr)
+
+	// renaming releases the previous moniker
+	result = handler(ctx, staking.NewMsgEditValidator(valAddr1, description("Carol"), nil, nil))
+	require.True(t, result.IsOK(), result.Log)
+	_, found = keeper.GetValidatorByMoniker(ctx, "bob")
+	require.False(t, found)
+	valAddr2 := createValidator(t, input, handler, createValidatorWithDescription(2, description("bob")))
+	valAddr, found = keeper.GetValidatorByMoniker(ctx, "Bob")
+	require.True(t, found)
+	require.Equal(t, valAddr2, valAddr)
+}
+
+func TestUniqueIdentity(t *testing.T) {
+	input, keeper, handler := createTestInput(t)
+	ctx := input.Ctx
+	createValidator(t, input, handler, createValidatorWithDescription(0, staking.NewDescription("Alice", "ABCD", "", "")))
+
+	// identities are not unique unless required by the params
+	valAddr1 := createValidator(t, input, handler, createValidatorWithDescription(1, staking.NewDescription("Bob", "abcd", "", "")))
+
+	setParams(ctx, keeper, func(params *Params) {
+		params.UniqueIdentity = true
+	})
+	result := handler(ctx, createValidatorWithDescription(2, staking.NewDescription("Carol", "abcd", "", "")))
+	requireError(t, result, DefaultCodespace, staking.CodeInvalidValidator)
+	createValidator(t, input, handler, createValidatorWithDescription(2, staking.NewDescription("Carol", "", "", "")))
+	createValidator(t, input, handler, createValidatorWithDescription(3, staking.NewDescription("Dave", "", "", "")))
+
+	// the identity stays registered to the first validator using it
+	result = handler(ctx, staking.NewMsgEditValidator(valAddr1, description("Bob2"), nil, nil))
+	require.True(t, result.IsOK(), result.Log)
+	valAddr, found := keeper.GetValidatorByIdentity(ctx, "abcd")
+	require.True(t, found)
+	require.Equal(t, sdk.ValAddress(addrs[0]), valAddr)
+}
+
+func TestRebuildDescriptionRegistry(t *testing.T) {
+	input, keeper, handler := createTestInput(t)
+	ctx := input.Ctx
+	valAddr0 := createValidator(t, input, handler, createValidatorWithDescription(0, staking.NewDescription("Alice", "ABCD", "", "")))
+	valAddr1 := createValidator(t, input, handler, createValidatorWithDescription(1, staking.NewDescription("Bob", "", "", "")))
+
+	// stale entries are replaced by the descriptions of the existing validators
+	ctx.KVStore(keeper.storeKey).Set(MonikerKey("alice"), valAddr1)
+	ctx.KVStore(keeper.storeKey).Set(MonikerKey("carol"), valAddr1)
+	InitGenesis(ctx, keeper, ExportGenesis(ctx, keeper))
+
+	valAddr, found := keeper.GetValidatorByMoniker(ctx, "alice")
+	require.True(t, found)
+	require.Equal(t, valAddr0, valAddr)
+	valAddr, found = keeper.GetValidatorByMoniker(ctx, "bob")
+	require.True(t, found)
+	require.Equal(t, valAddr1, valAddr)
+	valAddr, found = keeper.GetValidatorByIdentity(ctx, "abcd")
+	require.True(t, found)
+	require.Equal(t, valAddr0, valAddr)
+	_, found = keeper.GetValidatorByMoniker(ctx, "carol")
+	require.False(t, found)
+}
+
+func TestQueryMoniker(t *testing.T) {
+	input, keeper, handler := createTestInput(t)
+	ctx := input.Ctx
+	valAddr0 := createValidator(t, input, handler, createValidatorWithDescription(0, description("Alice")))
+	querier := NewQuerier(keeper)
+	query := func(moniker string) ([]byte, sdk.Error) {
+		data := ModuleCdc.MustMarshalJSON(NewQueryMonikerParams(moniker))
+		return querier(ctx, []string{QueryMoniker}, abci.RequestQuery{Data: data})
+	}
+
+	res, err := query("ALICE")
+	require.Nil(t, err)
+	var valAddr sdk.ValAddress
+	require.NoError(t, ModuleCdc.UnmarshalJSON(res, &valAddr))
+	require.Equal(t, valAddr0, valAddr)
+
+	_, err = query("bob")
+	require.NotNil(t, err)
+}
@@ -0,0 +1,58 @@
+package whitelist
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (keeper Keeper) GetRetirement(ctx sdk.Context, valAddr sdk.ValAddress) (retirement Retirement, found bool) {
+	bz := ctx.KVStore(keeper.storeKey).Get(RetirementKey(valAddr))
+	if bz == nil {
+		return retirement, false
+	}
+	keeper.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &retirement)
+	return retirement, true
+}
+
+func (keeper Keeper) SetRetirement(ctx sdk.Context, retirement Retirement) {
+	bz := keeper.cdc.MustMarshalBinaryLengthPrefixed(retirement)
+	ctx.KVStore(keeper.storeKey).Set(RetirementKey(retirement.ValidatorAddress), bz)
+}
+
+func (keeper Keeper) DeleteRetirement(ctx sdk.Context, valAddr sdk.ValAddress) {
+	ctx.KVStore(keeper.storeKey).Delete(RetirementKey(valAddr))
+}
+
+func (keeper Keeper) IterateRetirements(ctx sdk.Context, cb func(retirement Retirement) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(keeper.storeKey), RetirementKeyPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var retirement Retirement
+		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &retirement)
+		if cb(retirement) {
+			break
+		}
+	}
+}
+
+func (keeper Keeper) GetRetirements(ctx sdk.Context) (retirements Retirements) {
+	keeper.IterateRetirements(ctx, func(retirement Retirement) bool {
+		retirements = append(retirements, retirement)
+		return false
+	})
+	return retirements
+}
+
+// RemoveFromWhitelist removes the validator from the whitelist if it is in the whitelist, except the last validator in
+// the whitelist, since an empty whitelist allows any validator
+func (keeper Keeper) RemoveFromWhitelist(ctx sdk.Context, valAddr sdk.ValAddress) {
+	whitelist := keeper.GetWhitelist(ctx)
+	newWhitelist := Whitelist{}
+	for _, v := range whitelist {
+		if !valAddr.Equals(v) {
+			newWhitelist = append(newWhitelist, v)
+		}
+	}
+	if len(newWhitelist) != len(whitelist) && len(newWhitelist) > 0 {
+		keeper.SetWhitelist(ctx, newWhitelist)
+	}
+}
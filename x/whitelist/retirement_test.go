@@ -0,0 +1,118 @@
+package whitelist
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+)
+
+func countEvents(events sdk.Events, eventType string) (count int) {
+	for _, event := range events {
+		if event.Type == eventType {
+			count++
+		}
+	}
+	return count
+}
+
+func TestRetireValidator(t *testing.T) {
+	input, keeper, stakingHandler := createTestInput(t)
+	handler := NewHandler(keeper)
+	valAddr0 := createValidator(t, input, stakingHandler, createValidatorMsg(0, 10))
+	valAddr1 := createValidator(t, input, stakingHandler, createValidatorMsg(1, 10))
+	keeper.SetWhitelist(input.Ctx, Whitelist{valAddr0, valAddr1})
+	result := stakingHandler(input.Ctx, delegateMsg(2, valAddr0, tokens(5)))
+	require.True(t, result.IsOK(), result.Log)
+
+	ctx := input.Ctx.WithEventManager(sdk.NewEventManager())
+	result = handler(ctx, NewMsgRetireValidator(valAddr0))
+	require.True(t, result.IsOK(), result.Log)
+	require.Equal(t, 1, countEvents(result.Events, EventTypeRetireValidator))
+	// the other delegators are notified, after the self-delegation is unbonded
+	require.Equal(t, 1, countEvents(result.Events, EventTypeValidatorRetiring))
+	for _, event := range result.Events {
+		if event.Type != EventTypeValidatorRetiring {
+			continue
+		}
+		for _, attribute := range event.Attributes {
+			if string(attribute.Key) == AttributeKeyDelegator {
+				require.Equal(t, addrs[2].String(), string(attribute.Value))
+			}
+		}
+	}
+
+	completionTime := ctx.BlockHeader().Time.Add(input.StakingKeeper.UnbondingTime(ctx))
+	retirement, found := keeper.GetRetirement(ctx, valAddr0)
+	require.True(t, found)
+	require.Equal(t, completionTime, retirement.CompletionTime)
+	_, found = input.StakingKeeper.GetDelegation(ctx, addrs[0], valAddr0)
+	require.False(t, found)
+	_, found = input.StakingKeeper.GetUnbondingDelegation(ctx, addrs[0], valAddr0)
+	require.True(t, found)
+
+	result = handler(ctx, NewMsgRetireValidator(valAddr0))
+	requireError(t, result, DefaultCodespace, staking.CodeInvalidValidator)
+	result = handler(ctx, NewMsgRetireValidator(sdk.ValAddress(addrs[3])))
+	requireError(t, result, DefaultCodespace, staking.CodeInvalidValidator)
+
+	// the validator stays in the whitelist until the unbonding completes
+	ctx = input.NextBlock(input.StakingKeeper.UnbondingTime(ctx) / 2)
+	EndBlocker(ctx, keeper)
+	require.True(t, keeper.IsWhitelisted(ctx, valAddr0))
+
+	ctx = input.NextBlock(input.StakingKeeper.UnbondingTime(ctx) / 2)
+	EndBlocker(ctx, keeper)
+	require.Equal(t, Whitelist{valAddr1}, keeper.GetWhitelist(ctx))
+	_, found = keeper.GetRetirement(ctx, valAddr0)
+	require.False(t, found)
+	require.Equal(t, 1, countEvents(ctx.EventManager().Events(), EventTypeCompleteRetirement))
+}
+
+func TestRetireLastWhitelistedValidator(t *testing.T) {
+	input, keeper, stakingHandler := createTestInput(t)
+	handler := NewHandler(keeper)
+	valAddr0 := createValidator(t, input, stakingHandler, createValidatorMsg(0, 10))
+	createValidator(t, input, stakingHandler, createValidatorMsg(1, 10))
+	keeper.SetWhitelist(input.Ctx, Whitelist{valAddr0})
+
+	result := handler(input.Ctx, NewMsgRetireValidator(valAddr0))
+	require.True(t, result.IsOK(), result.Log)
+	ctx := input.NextBlock(input.StakingKeeper.UnbondingTime(input.Ctx))
+	EndBlocker(ctx, keeper)
+
+	// removing the last validator would leave an empty whitelist, which allows any validator
+	require.Equal(t, Whitelist{valAddr0}, keeper.GetWhitelist(ctx))
+	require.Empty(t, keeper.GetRetirements(ctx))
+}
+
+func TestRetirementGenesis(t *testing.T) {
+	input, keeper, stakingHandler := createTestInput(t)
+	ctx := input.Ctx
+	handler := NewHandler(keeper)
+	valAddr0 := createValidator(t, input, stakingHandler, createValidatorMsg(0, 10))
+	result := handler(ctx, NewMsgRetireValidator(valAddr0))
+	require.True(t, result.IsOK(), result.Log)
+
+	genesisState := ExportGenesis(ctx, keeper)
+	require.NoError(t, ValidateGenesis(genesisState))
+	require.Len(t, genesisState.Retirements, 1)
+
+	input2, keeper2, _ := createTestInput(t)
+	InitGenesis(input2.Ctx, keeper2, genesisState)
+	require.Equal(t, genesisState.Retirements, keeper2.GetRetirements(input2.Ctx))
+
+	res, err := NewQuerier(keeper2)(input2.Ctx, []string{QueryRetirements}, abci.RequestQuery{})
+	require.Nil(t, err)
+	var retirements Retirements
+	require.NoError(t, ModuleCdc.UnmarshalJSON(res, &retirements))
+	require.Len(t, retirements, 1)
+	require.Equal(t, valAddr0, retirements[0].ValidatorAddress)
+	require.True(t, genesisState.Retirements[0].CompletionTime.Equal(retirements[0].CompletionTime))
+
+	genesisState.Retirements = append(genesisState.Retirements, genesisState.Retirements[0])
+	require.Error(t, ValidateGenesis(genesisState))
+}
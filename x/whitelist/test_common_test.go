@@ -0,0 +1,215 @@
+package whitelist
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/libs/log"
+	tmtypes "github.com/tendermint/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+)
+
+// dummy accounts used in tests, each funded with initTokens
+var (
+	pubKeys = createTestPubKeys(100)
+	addrs   = createTestAddrs(pubKeys)
+
+	initTokens = sdk.TokensFromConsensusPower(1000)
+)
+
+func createTestPubKeys(n int) []crypto.PubKey {
+	pubKeys := make([]crypto.PubKey, n)
+	for i := range pubKeys {
+		var secret [8]byte
+		secret[0], secret[1] = byte(i>>8), byte(i)
+		pubKeys[i] = ed25519.GenPrivKeyFromSecret(secret[:]).PubKey()
+	}
+	return pubKeys
+}
+
+func createTestAddrs(pubKeys []crypto.PubKey) []sdk.AccAddress {
+	addrs := make([]sdk.AccAddress, len(pubKeys))
+	for i, pubKey := range pubKeys {
+		addrs[i] = sdk.AccAddress(pubKey.Address())
+	}
+	return addrs
+}
+
+func makeTestCodec() *codec.Codec {
+	cdc := codec.New()
+	sdk.RegisterCodec(cdc)
+	codec.RegisterCrypto(cdc)
+	auth.RegisterCodec(cdc)
+	bank.RegisterCodec(cdc)
+	staking.RegisterCodec(cdc)
+	supply.RegisterCodec(cdc)
+	RegisterCodec(cdc)
+	return cdc
+}
+
+// testInput holds the context and the keepers sharing the same multistore
+type testInput struct {
+	Ctx sdk.Context
+	Cdc *codec.Codec
+	// key of the params store, for removing the params which were never set on the live chain
+	ParamsKey sdk.StoreKey
+
+	StakingKeeper staking.Keeper
+}
+
+// createTestInput returns the input with the whitelist keeper using the default params, and the staking handler
+// wrapped by the whitelist keeper
+func createTestInput(t testing.TB) (*testInput, Keeper, sdk.Handler) {
+	keys := sdk.NewKVStoreKeys(
+		auth.StoreKey, params.StoreKey, supply.StoreKey, staking.StoreKey, StoreKey,
+	)
+	tkeys := sdk.NewTransientStoreKeys(params.TStoreKey, staking.TStoreKey)
+
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+	for _, key := range keys {
+		ms.MountStoreWithDB(key, sdk.StoreTypeIAVL, db)
+	}
+	for _, tkey := range tkeys {
+		ms.MountStoreWithDB(tkey, sdk.StoreTypeTransient, db)
+	}
+	err := ms.LoadLatestVersion()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	header := abci.Header{ChainID: "likechain-test", Height: 1, Time: time.Unix(1500000000, 0).UTC()}
+	ctx := sdk.NewContext(ms, header, false, log.NewNopLogger())
+	ctx = ctx.WithConsensusParams(&abci.ConsensusParams{
+		Validator: &abci.ValidatorParams{PubKeyTypes: []string{tmtypes.ABCIPubKeyTypeEd25519}},
+	})
+	cdc := makeTestCodec()
+
+	maccPerms := map[string][]string{
+		staking.BondedPoolName:    {supply.Burner, supply.Staking},
+		staking.NotBondedPoolName: {supply.Burner, supply.Staking},
+	}
+	blacklistedAddrs := map[string]bool{}
+	for name := range maccPerms {
+		blacklistedAddrs[supply.NewModuleAddress(name).String()] = true
+	}
+
+	paramsKeeper := params.NewKeeper(cdc, keys[params.StoreKey], tkeys[params.TStoreKey], params.DefaultCodespace)
+	accountKeeper := auth.NewAccountKeeper(cdc, keys[auth.StoreKey], paramsKeeper.Subspace(auth.DefaultParamspace),
+		auth.ProtoBaseAccount)
+	bankKeeper := bank.NewBaseKeeper(accountKeeper, paramsKeeper.Subspace(bank.DefaultParamspace), bank.DefaultCodespace,
+		blacklistedAddrs)
+	supplyKeeper := supply.NewKeeper(cdc, keys[supply.StoreKey], accountKeeper, bankKeeper, maccPerms)
+	stakingKeeper := staking.NewKeeper(cdc, keys[staking.StoreKey], tkeys[staking.TStoreKey], supplyKeeper,
+		paramsKeeper.Subspace(staking.DefaultParamspace), staking.DefaultCodespace)
+	keeper := NewKeeper(
+		cdc, keys[StoreKey], paramsKeeper.Subspace(DefaultParamspace), stakingKeeper, DefaultCodespace,
+	)
+
+	bankKeeper.SetSendEnabled(ctx, true)
+	stakingKeeper.SetParams(ctx, staking.DefaultParams())
+	totalSupply := sdk.NewCoins()
+	for _, addr := range addrs {
+		coins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, initTokens))
+		_, err := bankKeeper.AddCoins(ctx, addr, coins)
+		if err != nil {
+			t.Fatal(err)
+		}
+		totalSupply = totalSupply.Add(coins)
+	}
+	supplyKeeper.SetSupply(ctx, supply.NewSupply(totalSupply))
+	for name, perms := range maccPerms {
+		supplyKeeper.SetModuleAccount(ctx, supply.NewEmptyModuleAccount(name, perms...))
+	}
+	InitGenesis(ctx, keeper, DefaultGenesisState())
+
+	input := &testInput{
+		Ctx:           ctx,
+		Cdc:           cdc,
+		ParamsKey:     keys[params.StoreKey],
+		StakingKeeper: stakingKeeper,
+	}
+	return input, keeper, WrapStakingHandler(keeper, staking.NewHandler(stakingKeeper))
+}
+
+// NextBlock returns the context of the next block, the given duration after the current block
+func (input *testInput) NextBlock(d time.Duration) sdk.Context {
+	header := input.Ctx.BlockHeader()
+	header.Height++
+	header.Time = header.Time.Add(d)
+	input.Ctx = input.Ctx.WithBlockHeader(header)
+	return input.Ctx
+}
+
+func setParams(ctx sdk.Context, keeper Keeper, update func(params *Params)) {
+	params := keeper.GetParams(ctx)
+	update(&params)
+	keeper.SetParams(ctx, params)
+}
+
+// deleteParams removes the params from the store, as if they were added to the module after the chain started
+func deleteParams(ctx sdk.Context, input *testInput, keys ...[]byte) {
+	store := prefix.NewStore(ctx.KVStore(input.ParamsKey), []byte(DefaultParamspace+"/"))
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+func tokens(power int64) sdk.Int {
+	return sdk.TokensFromConsensusPower(power)
+}
+
+func description(moniker string) staking.Description {
+	return staking.NewDescription(moniker, "", "", "")
+}
+
+func validatorDescription(i int) staking.Description {
+	return description(fmt.Sprintf("validator-%d", i))
+}
+
+// createValidatorMsg returns the message creating the validator operated by addrs[i] with the self-delegation of the
+// given consensus power, which is also the MinSelfDelegation, and without commission
+func createValidatorMsg(i int, power int64) staking.MsgCreateValidator {
+	return staking.NewMsgCreateValidator(
+		sdk.ValAddress(addrs[i]), pubKeys[i], sdk.NewCoin(sdk.DefaultBondDenom, tokens(power)), validatorDescription(i),
+		staking.NewCommissionRates(sdk.ZeroDec(), sdk.OneDec(), sdk.OneDec()), tokens(power),
+	)
+}
+
+// editValidatorMsg returns the message editing the commission rate and the MinSelfDelegation of the validator operated
+// by addrs[i] without changing its description
+func editValidatorMsg(i int, commissionRate *sdk.Dec, minSelfDelegation *sdk.Int) staking.MsgEditValidator {
+	return staking.NewMsgEditValidator(
+		sdk.ValAddress(addrs[i]), description(staking.DoNotModifyDesc), commissionRate, minSelfDelegation,
+	)
+}
+
+// createValidator creates the validator through the wrapped handler, and applies the validator set updates
+func createValidator(t *testing.T, input *testInput, handler sdk.Handler, msg staking.MsgCreateValidator) sdk.ValAddress {
+	result := handler(input.Ctx, msg)
+	require.True(t, result.IsOK(), result.Log)
+	staking.EndBlocker(input.Ctx, input.StakingKeeper)
+	return msg.ValidatorAddress
+}
+
+func requireError(t *testing.T, result sdk.Result, codespace sdk.CodespaceType, code sdk.CodeType) {
+	require.False(t, result.IsOK())
+	require.Equal(t, codespace, result.Codespace, result.Log)
+	require.Equal(t, code, result.Code, result.Log)
+}
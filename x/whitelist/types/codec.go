@@ -6,6 +6,7 @@ import (
 
 func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgSetWhitelist{}, "likechain/MsgSetWhitelist", nil)
+	cdc.RegisterConcrete(MsgRetireValidator{}, "likechain/MsgRetireValidator", nil)
 }
 
 var ModuleCdc *codec.Codec
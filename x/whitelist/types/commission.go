@@ -0,0 +1,64 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// CommissionBounds are the bounds on the commission of validators
+type CommissionBounds struct {
+	MinRate       sdk.Dec `json:"min_rate" yaml:"min_rate"`
+	MaxRate       sdk.Dec `json:"max_rate" yaml:"max_rate"`
+	MaxChangeRate sdk.Dec `json:"max_change_rate" yaml:"max_change_rate"`
+}
+
+func NewCommissionBounds(minRate, maxRate, maxChangeRate sdk.Dec) CommissionBounds {
+	return CommissionBounds{
+		MinRate:       minRate,
+		MaxRate:       maxRate,
+		MaxChangeRate: maxChangeRate,
+	}
+}
+
+func (b CommissionBounds) Validate() error {
+	isValidRate := func(rate sdk.Dec) bool {
+		return !rate.IsNil() && !rate.IsNegative() && rate.LTE(sdk.OneDec())
+	}
+	if !isValidRate(b.MinRate) || !isValidRate(b.MaxRate) || !isValidRate(b.MaxChangeRate) {
+		return fmt.Errorf("commission bounds must be between 0 and 1: %s", b)
+	}
+	if b.MinRate.GT(b.MaxRate) {
+		return fmt.Errorf("min commission rate %s is greater than max commission rate %s", b.MinRate, b.MaxRate)
+	}
+	return nil
+}
+
+// CheckRate returns an error if the commission rate is out of the bounds
+func (b CommissionBounds) CheckRate(codespace sdk.CodespaceType, rate sdk.Dec) sdk.Error {
+	if rate.LT(b.MinRate) || rate.GT(b.MaxRate) {
+		return ErrCommissionOutOfBounds(codespace, fmt.Sprintf("commission rate must be between %s and %s", b.MinRate, b.MaxRate))
+	}
+	return nil
+}
+
+// CheckCommission returns an error if the commission of a new validator is out of the bounds
+func (b CommissionBounds) CheckCommission(codespace sdk.CodespaceType, commission sdk.Dec, maxRate sdk.Dec, maxChangeRate sdk.Dec) sdk.Error {
+	if err := b.CheckRate(codespace, commission); err != nil {
+		return err
+	}
+	if maxRate.GT(b.MaxRate) {
+		return ErrCommissionOutOfBounds(codespace, fmt.Sprintf("max commission rate must be at most %s", b.MaxRate))
+	}
+	if maxChangeRate.GT(b.MaxChangeRate) {
+		return ErrCommissionOutOfBounds(codespace, fmt.Sprintf("max commission change rate must be at most %s", b.MaxChangeRate))
+	}
+	return nil
+}
+
+func (b CommissionBounds) String() string {
+	return fmt.Sprintf(`Commission Bounds:
+  Min Rate:        %s
+  Max Rate:        %s
+  Max Change Rate: %s`, b.MinRate, b.MaxRate, b.MaxChangeRate)
+}
@@ -0,0 +1,75 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DelegationCap is the cap on the tokens of a validator, zero values mean no cap
+type DelegationCap struct {
+	MaxTokens     sdk.Int `json:"max_tokens" yaml:"max_tokens"`
+	MaxPowerRatio sdk.Dec `json:"max_power_ratio" yaml:"max_power_ratio"`
+}
+
+func NewDelegationCap(maxTokens sdk.Int, maxPowerRatio sdk.Dec) DelegationCap {
+	return DelegationCap{
+		MaxTokens:     maxTokens,
+		MaxPowerRatio: maxPowerRatio,
+	}
+}
+
+func (c DelegationCap) Validate() error {
+	if c.MaxTokens.BigInt() == nil || c.MaxTokens.IsNegative() {
+		return fmt.Errorf("max validator tokens must not be negative: %s", c.MaxTokens)
+	}
+	if c.MaxPowerRatio.IsNil() || c.MaxPowerRatio.IsNegative() || c.MaxPowerRatio.GT(sdk.OneDec()) {
+		return fmt.Errorf("max validator power ratio must be between 0 and 1: %s", c.MaxPowerRatio)
+	}
+	return nil
+}
+
+// Limit returns the max tokens of a validator under the total bonded tokens, and false if there is no cap
+func (c DelegationCap) Limit(totalBondedTokens sdk.Int) (limit sdk.Int, capped bool) {
+	if c.MaxTokens.IsPositive() {
+		limit = c.MaxTokens
+		capped = true
+	}
+	if c.MaxPowerRatio.IsPositive() {
+		ratioLimit := c.MaxPowerRatio.MulInt(totalBondedTokens).TruncateInt()
+		if !capped || ratioLimit.LT(limit) {
+			limit = ratioLimit
+			capped = true
+		}
+	}
+	return limit, capped
+}
+
+func (c DelegationCap) String() string {
+	return fmt.Sprintf(`Delegation Cap:
+  Max Tokens:      %s
+  Max Power Ratio: %s`, c.MaxTokens, c.MaxPowerRatio)
+}
+
+// DelegationRoom is the amount of tokens which can still be delegated to a validator
+type DelegationRoom struct {
+	ValidatorAddress sdk.ValAddress `json:"validator_address" yaml:"validator_address"`
+	Tokens           sdk.Int        `json:"tokens" yaml:"tokens"`
+	Capped           bool           `json:"capped" yaml:"capped"`
+	Limit            sdk.Int        `json:"limit" yaml:"limit"`
+	Room             sdk.Int        `json:"room" yaml:"room"`
+}
+
+func (r DelegationRoom) String() string {
+	if !r.Capped {
+		return fmt.Sprintf(`Delegation Room:
+  Validator: %s
+  Tokens:    %s
+  Room:      unlimited`, r.ValidatorAddress, r.Tokens)
+	}
+	return fmt.Sprintf(`Delegation Room:
+  Validator: %s
+  Tokens:    %s
+  Limit:     %s
+  Room:      %s`, r.ValidatorAddress, r.Tokens, r.Limit, r.Room)
+}
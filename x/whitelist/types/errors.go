@@ -1,6 +1,8 @@
 package types
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 )
@@ -16,3 +18,31 @@ func ErrInvalidApprover(codespace sdk.CodespaceType) sdk.Error {
 func ErrValidatorNotInWEhitelist(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, staking.CodeInvalidValidator, "validator not in whitelist")
 }
+
+func ErrMinSelfDelegationTooLow(codespace sdk.CodespaceType, minSelfDelegation sdk.Int) sdk.Error {
+	return sdk.NewError(codespace, staking.CodeInvalidInput, fmt.Sprintf("min self delegation must be at least %s", minSelfDelegation))
+}
+
+func ErrCommissionOutOfBounds(codespace sdk.CodespaceType, reason string) sdk.Error {
+	return sdk.NewError(codespace, staking.CodeInvalidValidator, fmt.Sprintf("commission out of bounds: %s", reason))
+}
+
+func ErrDelegationCapExceeded(codespace sdk.CodespaceType, validator sdk.ValAddress, room sdk.Int) sdk.Error {
+	return sdk.NewError(codespace, staking.CodeInvalidDelegation, fmt.Sprintf("delegation exceeds the cap of validator %s, at most %s more tokens can be delegated", validator, room))
+}
+
+func ErrDelegationNotInWhitelist(codespace sdk.CodespaceType, validator sdk.ValAddress) sdk.Error {
+	return sdk.NewError(codespace, staking.CodeInvalidValidator, fmt.Sprintf("cannot delegate to validator %s which is not in whitelist", validator))
+}
+
+func ErrMonikerTaken(codespace sdk.CodespaceType, moniker string, validator sdk.ValAddress) sdk.Error {
+	return sdk.NewError(codespace, staking.CodeInvalidValidator, fmt.Sprintf("moniker %s is already used by validator %s", moniker, validator))
+}
+
+func ErrIdentityTaken(codespace sdk.CodespaceType, identity string, validator sdk.ValAddress) sdk.Error {
+	return sdk.NewError(codespace, staking.CodeInvalidValidator, fmt.Sprintf("identity %s is already used by validator %s", identity, validator))
+}
+
+func ErrValidatorRetiring(codespace sdk.CodespaceType, validator sdk.ValAddress) sdk.Error {
+	return sdk.NewError(codespace, staking.CodeInvalidValidator, fmt.Sprintf("validator %s is already retiring", validator))
+}
@@ -1,8 +1,14 @@
 package types
 
 var (
-	EventTypeSetWhitelist = "set_whitelist"
+	EventTypeSetWhitelist       = "set_whitelist"
+	EventTypeRetireValidator    = "retire_validator"
+	EventTypeValidatorRetiring  = "validator_retiring"
+	EventTypeCompleteRetirement = "complete_retirement"
 
-	AttributeKeyWhitelist  = "whitelist"
-	AttributeValueCategory = ModuleName
+	AttributeKeyWhitelist      = "whitelist"
+	AttributeKeyValidator      = "validator"
+	AttributeKeyDelegator      = "delegator"
+	AttributeKeyCompletionTime = "completion_time"
+	AttributeValueCategory     = ModuleName
 )
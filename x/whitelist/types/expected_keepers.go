@@ -0,0 +1,21 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/exported"
+	staking "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// StakingKeeper expected staking keeper for checking the tokens and descriptions of validators, and unbonding the
+// self-delegation of retiring validators
+type StakingKeeper interface {
+	Validator(ctx sdk.Context, address sdk.ValAddress) exported.ValidatorI
+	TotalBondedTokens(ctx sdk.Context) sdk.Int
+	GetValidator(ctx sdk.Context, addr sdk.ValAddress) (validator staking.Validator, found bool)
+	GetAllValidators(ctx sdk.Context) (validators []staking.Validator)
+	GetDelegation(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (delegation staking.Delegation, found bool)
+	GetValidatorDelegations(ctx sdk.Context, valAddr sdk.ValAddress) (delegations []staking.Delegation)
+	Undelegate(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, sharesAmount sdk.Dec) (time.Time, sdk.Error)
+}
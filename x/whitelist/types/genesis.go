@@ -1,18 +1,34 @@
 package types
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 type GenesisState struct {
 	Whitelist []sdk.ValAddress `json:"whitelist" yaml:"whitelist"`
 	Params    Params           `json:"params" yaml:"params"`
+
+	Retirements Retirements `json:"retirements,omitempty" yaml:"retirements"`
 }
 
 func DefaultGenesisState() GenesisState {
-	return GenesisState{}
+	return GenesisState{
+		Params: DefaultParams(),
+	}
 }
 
 func ValidateGenesis(data GenesisState) error {
-	return nil
+	seenRetirements := make(map[string]bool)
+	for _, retirement := range data.Retirements {
+		if retirement.ValidatorAddress.Empty() {
+			return fmt.Errorf("retirement with empty validator address")
+		}
+		if seenRetirements[retirement.ValidatorAddress.String()] {
+			return fmt.Errorf("duplicated retirement of validator %s", retirement.ValidatorAddress)
+		}
+		seenRetirements[retirement.ValidatorAddress.String()] = true
+	}
+	return data.Params.Validate()
 }
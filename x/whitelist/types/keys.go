@@ -1,5 +1,11 @@
 package types
 
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 const (
 	ModuleName   = "whitelist"
 	StoreKey     = ModuleName
@@ -9,4 +15,29 @@ const (
 
 var (
 	WhitelistKey = []byte{0x11}
+
+	MonikerKeyPrefix  = []byte{0x12}
+	IdentityKeyPrefix = []byte{0x13}
+
+	RetirementKeyPrefix = []byte{0x14}
 )
+
+// RetirementKey gets the key for the retirement of the validator
+func RetirementKey(valAddr sdk.ValAddress) []byte {
+	return append(RetirementKeyPrefix, valAddr.Bytes()...)
+}
+
+// NormalizeDescriptionField normalizes the moniker and identity for the case-insensitive uniqueness checks
+func NormalizeDescriptionField(field string) string {
+	return strings.ToLower(strings.TrimSpace(field))
+}
+
+// MonikerKey gets the key for the operator address of the validator using the moniker
+func MonikerKey(moniker string) []byte {
+	return append(MonikerKeyPrefix, []byte(NormalizeDescriptionField(moniker))...)
+}
+
+// IdentityKey gets the key for the operator address of the validator using the identity
+func IdentityKey(identity string) []byte {
+	return append(IdentityKeyPrefix, []byte(NormalizeDescriptionField(identity))...)
+}
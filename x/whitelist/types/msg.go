@@ -2,6 +2,7 @@ package types
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
 )
 
 var _ sdk.Msg = &MsgSetWhitelist{}
@@ -36,3 +37,36 @@ func (msg MsgSetWhitelist) ValidateBasic() sdk.Error {
 	}
 	return nil
 }
+
+var _ sdk.Msg = &MsgRetireValidator{}
+
+// MsgRetireValidator unbonds the self-delegation of the validator, and removes it from the whitelist when the
+// unbonding completes
+type MsgRetireValidator struct {
+	ValidatorAddress sdk.ValAddress `json:"validator_address" yaml:"validator_address"`
+}
+
+func NewMsgRetireValidator(valAddr sdk.ValAddress) MsgRetireValidator {
+	return MsgRetireValidator{
+		ValidatorAddress: valAddr,
+	}
+}
+
+func (msg MsgRetireValidator) Route() string { return RouterKey }
+func (msg MsgRetireValidator) Type() string  { return "retire_validator" }
+
+func (msg MsgRetireValidator) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.ValidatorAddress)}
+}
+
+func (msg MsgRetireValidator) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgRetireValidator) ValidateBasic() sdk.Error {
+	if msg.ValidatorAddress.Empty() {
+		return staking.ErrNilValidatorAddr(DefaultCodespace)
+	}
+	return nil
+}
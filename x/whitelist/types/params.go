@@ -10,10 +10,39 @@ import (
 
 type Params struct {
 	Approver sdk.AccAddress `json:"approver" yaml:"approver"`
+
+	// minimum of MinSelfDelegation of validators, checked on validator creation and editing
+	MinSelfDelegation sdk.Int `json:"min_self_delegation,omitempty" yaml:"min_self_delegation"`
+
+	// bounds on the commission of validators, checked on validator creation and editing
+	MinCommissionRate       sdk.Dec `json:"min_commission_rate,omitempty" yaml:"min_commission_rate"`
+	MaxCommissionRate       sdk.Dec `json:"max_commission_rate,omitempty" yaml:"max_commission_rate"`
+	MaxCommissionChangeRate sdk.Dec `json:"max_commission_change_rate,omitempty" yaml:"max_commission_change_rate"`
+
+	// cap on the tokens of a validator, checked on delegation and redelegation, zero means no cap
+	MaxValidatorTokens     sdk.Int `json:"max_validator_tokens,omitempty" yaml:"max_validator_tokens"`
+	MaxValidatorPowerRatio sdk.Dec `json:"max_validator_power_ratio,omitempty" yaml:"max_validator_power_ratio"`
+
+	// whether delegation and redelegation are restricted to the validators in the whitelist
+	RestrictDelegation bool `json:"restrict_delegation,omitempty" yaml:"restrict_delegation"`
+
+	// whether the identities of validators must be unique, monikers are always unique
+	UniqueIdentity bool `json:"unique_identity,omitempty" yaml:"unique_identity"`
 }
 
 var (
-	KeyApprover = []byte("Approver")
+	KeyApprover          = []byte("Approver")
+	KeyMinSelfDelegation = []byte("MinSelfDelegation")
+
+	KeyMinCommissionRate       = []byte("MinCommissionRate")
+	KeyMaxCommissionRate       = []byte("MaxCommissionRate")
+	KeyMaxCommissionChangeRate = []byte("MaxCommissionChangeRate")
+
+	KeyMaxValidatorTokens     = []byte("MaxValidatorTokens")
+	KeyMaxValidatorPowerRatio = []byte("MaxValidatorPowerRatio")
+
+	KeyRestrictDelegation = []byte("RestrictDelegation")
+	KeyUniqueIdentity     = []byte("UniqueIdentity")
 )
 
 var _ params.ParamSet = (*Params)(nil)
@@ -21,17 +50,61 @@ var _ params.ParamSet = (*Params)(nil)
 // Implements params.ParamSet
 func (p *Params) ParamSetPairs() params.ParamSetPairs {
 	return params.ParamSetPairs{
-		{KeyApprover, &p.Approver},
+		{Key: KeyApprover, Value: &p.Approver},
+		{Key: KeyMinSelfDelegation, Value: &p.MinSelfDelegation},
+		{Key: KeyMinCommissionRate, Value: &p.MinCommissionRate},
+		{Key: KeyMaxCommissionRate, Value: &p.MaxCommissionRate},
+		{Key: KeyMaxCommissionChangeRate, Value: &p.MaxCommissionChangeRate},
+		{Key: KeyMaxValidatorTokens, Value: &p.MaxValidatorTokens},
+		{Key: KeyMaxValidatorPowerRatio, Value: &p.MaxValidatorPowerRatio},
+		{Key: KeyRestrictDelegation, Value: &p.RestrictDelegation},
+		{Key: KeyUniqueIdentity, Value: &p.UniqueIdentity},
 	}
 }
 
 func DefaultParams() Params {
-	return Params{}
+	return Params{
+		MinSelfDelegation:       sdk.OneInt(),
+		MinCommissionRate:       sdk.ZeroDec(),
+		MaxCommissionRate:       sdk.OneDec(),
+		MaxCommissionChangeRate: sdk.OneDec(),
+		MaxValidatorTokens:      sdk.ZeroInt(),
+		MaxValidatorPowerRatio:  sdk.ZeroDec(),
+	}
+}
+
+// CommissionBounds returns the commission bounds in the params
+func (p Params) CommissionBounds() CommissionBounds {
+	return NewCommissionBounds(p.MinCommissionRate, p.MaxCommissionRate, p.MaxCommissionChangeRate)
+}
+
+// DelegationCap returns the cap on the tokens of validators in the params
+func (p Params) DelegationCap() DelegationCap {
+	return NewDelegationCap(p.MaxValidatorTokens, p.MaxValidatorPowerRatio)
+}
+
+func (p Params) Validate() error {
+	if p.MinSelfDelegation.BigInt() == nil || !p.MinSelfDelegation.IsPositive() {
+		return fmt.Errorf("min self delegation must be positive: %s", p.MinSelfDelegation)
+	}
+	if err := p.CommissionBounds().Validate(); err != nil {
+		return err
+	}
+	return p.DelegationCap().Validate()
 }
 
 func (p Params) String() string {
 	return fmt.Sprintf(`Params:
-  Whitelist Approver: %s`, p.Approver)
+  Whitelist Approver:  %s
+  Min Self Delegation: %s
+  Commission Rate:     %s - %s
+  Max Commission Change Rate: %s
+  Max Validator Tokens:       %s
+  Max Validator Power Ratio:  %s
+  Restrict Delegation:        %t
+  Unique Identity:            %t`,
+		p.Approver, p.MinSelfDelegation, p.MinCommissionRate, p.MaxCommissionRate, p.MaxCommissionChangeRate,
+		p.MaxValidatorTokens, p.MaxValidatorPowerRatio, p.RestrictDelegation, p.UniqueIdentity)
 }
 
 func MustUnmarshalParams(cdc *codec.Codec, value []byte) Params {
@@ -1,6 +1,38 @@
 package types
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 const (
 	QueryApprover  = "approver"
 	QueryWhitelist = "whitelist"
+	QueryParams    = "params"
+
+	QueryCommissionBounds = "commission_bounds"
+	QueryDelegationRoom   = "delegation_room"
+	QueryMoniker          = "moniker"
+	QueryRetirements      = "retirements"
 )
+
+// QueryDelegationRoomParams are the params for querying the delegation room of a validator
+type QueryDelegationRoomParams struct {
+	ValidatorAddress sdk.ValAddress
+}
+
+func NewQueryDelegationRoomParams(validatorAddr sdk.ValAddress) QueryDelegationRoomParams {
+	return QueryDelegationRoomParams{
+		ValidatorAddress: validatorAddr,
+	}
+}
+
+// QueryMonikerParams are the params for querying the operator address of the validator using a moniker
+type QueryMonikerParams struct {
+	Moniker string
+}
+
+func NewQueryMonikerParams(moniker string) QueryMonikerParams {
+	return QueryMonikerParams{
+		Moniker: moniker,
+	}
+}
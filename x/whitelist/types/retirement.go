@@ -0,0 +1,42 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Retirement is a validator leaving the network, which is removed from the whitelist when its self-delegation
+// completes unbonding
+type Retirement struct {
+	ValidatorAddress sdk.ValAddress `json:"validator_address" yaml:"validator_address"`
+	CompletionTime   time.Time      `json:"completion_time" yaml:"completion_time"`
+}
+
+func NewRetirement(valAddr sdk.ValAddress, completionTime time.Time) Retirement {
+	return Retirement{
+		ValidatorAddress: valAddr,
+		CompletionTime:   completionTime,
+	}
+}
+
+func (r Retirement) String() string {
+	return fmt.Sprintf(`Retirement:
+  Validator:       %s
+  Completion Time: %s`, r.ValidatorAddress, r.CompletionTime)
+}
+
+type Retirements []Retirement
+
+func (rs Retirements) String() string {
+	if len(rs) == 0 {
+		return "[]"
+	}
+	out := make([]string, 0, len(rs))
+	for _, r := range rs {
+		out = append(out, r.String())
+	}
+	return strings.Join(out, "\n")
+}